@@ -96,10 +96,10 @@ func (arr *Array[T]) Get(index int) T { return arr.slice[arr.getRealIndex(index)
 func (arr *Array[T]) Set(index int, value T) { arr.slice[arr.getRealIndex(index)] = value }
 
 // Prepend inserts the specified element at the beginning.
-func (arr *Array[T]) Prepend(value T) { slices.Insert(arr.slice, 0, value) }
+func (arr *Array[T]) Prepend(value T) { arr.slice = slices.Insert(arr.slice, 0, value) }
 
 // PrependMany inserts the specified elements at the beginning.
-func (arr *Array[T]) PrependMany(values ...T) { slices.Insert(arr.slice, 0, values...) }
+func (arr *Array[T]) PrependMany(values ...T) { arr.slice = slices.Insert(arr.slice, 0, values...) }
 
 // Append appends the specified element to the end.
 func (arr *Array[T]) Append(value T) { arr.slice = append(arr.slice, value) }
@@ -113,7 +113,7 @@ func (arr *Array[T]) AppendMany(values ...T) { arr.slice = append(arr.slice, val
 // Panic occurs if the index is out of bounds.
 func (arr *Array[T]) Insert(index int, value T) {
 	index = arr.getRealIndex(index)
-	slices.Insert(arr.slice, index, value)
+	arr.slice = slices.Insert(arr.slice, index, value)
 }
 
 // InsertMany inserts the specified elements at the specified index.
@@ -122,7 +122,7 @@ func (arr *Array[T]) Insert(index int, value T) {
 // Panic occurs if the index is out of bounds.
 func (arr *Array[T]) InsertMany(index int, values ...T) {
 	index = arr.getRealIndex(index)
-	slices.Insert(arr.slice, index, values...)
+	arr.slice = slices.Insert(arr.slice, index, values...)
 }
 
 // Remove removes the element at the specified index.
@@ -131,7 +131,7 @@ func (arr *Array[T]) InsertMany(index int, values ...T) {
 // Panic occurs if the index is out of bounds.
 func (arr *Array[T]) Remove(index int) {
 	index = arr.getRealIndex(index)
-	slices.Delete(arr.slice, index, index+1)
+	arr.slice = slices.Delete(arr.slice, index, index+1)
 }
 
 // Reserve reserves additional capacity.
@@ -219,6 +219,28 @@ func (arr *Array[T]) Stream2(yield func(int, T) bool) {
 	}
 }
 
+// ReverseStream streams all elements in reverse order.
+//
+// It is not safe to modify the Array while streaming.
+func (arr *Array[T]) ReverseStream(yield func(T) bool) {
+	for i := len(arr.slice) - 1; i >= 0; i-- {
+		if !yield(arr.slice[i]) {
+			break
+		}
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+//
+// It is not safe to modify the Array while streaming.
+func (arr *Array[T]) ReverseStream2(yield func(int, T) bool) {
+	for i := len(arr.slice) - 1; i >= 0; i-- {
+		if !yield(i, arr.slice[i]) {
+			break
+		}
+	}
+}
+
 // FindIndex returns the index of the first element
 // that satisfies the specified predicate.
 // If no such element is found, 0 and false are returned.
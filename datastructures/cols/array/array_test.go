@@ -1,6 +1,9 @@
 package array
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestAppend(t *testing.T) {
 	arr := FromSlice([]int{1, 2, 3})
@@ -12,3 +15,17 @@ func TestAppend(t *testing.T) {
 		t.Log(i, val)
 	}
 }
+
+func TestInsertRemove(t *testing.T) {
+	arr := FromSlice([]int{3, 6})
+
+	arr.Prepend(2)
+	arr.PrependMany(0, 1)
+	arr.Insert(4, 4)
+	arr.InsertMany(-1, 5, 5)
+	arr.Remove(-2)
+
+	if got := arr.Slice(); !slices.Equal(got, []int{0, 1, 2, 3, 4, 5, 6}) {
+		t.Errorf("elements are %v", got)
+	}
+}
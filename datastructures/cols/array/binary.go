@@ -0,0 +1,49 @@
+package array
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "array.Array"
+
+// EncodeBinary writes the Array to the writer
+// in the serial format, element by element.
+func (arr *Array[T]) EncodeBinary(w io.Writer) error {
+	return serial.EncodeStream(w, binaryKind, len(arr.slice), arr.Stream)
+}
+
+// DecodeBinary replaces the elements of the Array
+// with the ones read from the reader in the serial format.
+func (arr *Array[T]) DecodeBinary(r io.Reader) error {
+	var slice []T
+	err := serial.DecodeStream(r, binaryKind, func(value T) {
+		slice = append(slice, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	arr.slice = slice
+
+	return nil
+}
+
+// MarshalBinary encodes the Array in the serial format.
+func (arr *Array[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := arr.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Array from the serial format.
+func (arr *Array[T]) UnmarshalBinary(data []byte) error {
+	return arr.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Array in the serial format.
+func (arr *Array[T]) GobEncode() ([]byte, error) { return arr.MarshalBinary() }
+
+// GobDecode decodes the Array from the serial format.
+func (arr *Array[T]) GobDecode(data []byte) error { return arr.UnmarshalBinary(data) }
@@ -0,0 +1,52 @@
+package array
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"slices"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	arr := FromSlice([]string{"a", "", "c"})
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(arr); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := FromSlice([]string{"old"})
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := decoded.Slice(); !slices.Equal(got, arr.Slice()) {
+		t.Errorf("decoded elements are %q", got)
+	}
+
+	data, _ := New[string]().MarshalBinary()
+	if err := decoded.UnmarshalBinary(data); err != nil || decoded.Size() != 0 {
+		t.Errorf("UnmarshalBinary() of an empty array left %d elements, %v", decoded.Size(), err)
+	}
+}
+
+func TestDecodeBinaryErrors(t *testing.T) {
+	var buf bytes.Buffer
+	serial.EncodeStream(&buf, "linklist.List", 1, slices.Values([]int{1}))
+
+	arr := FromSlice([]int{5})
+	if err := arr.DecodeBinary(&buf); !errors.As(err, new(serial.KindMismatchError)) {
+		t.Errorf("DecodeBinary() of another kind returned %v", err)
+	}
+
+	data, _ := FromSlice([]int{1, 2, 3}).MarshalBinary()
+	if err := arr.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("UnmarshalBinary() of truncated data succeeded")
+	}
+
+	if got := arr.Slice(); !slices.Equal(got, []int{5}) {
+		t.Errorf("failed decoding changed the elements to %v", got)
+	}
+}
@@ -1,5 +1,7 @@
 package array
 
+import "github.com/djordje200179/extendedlibrary/datastructures/iter"
+
 // Iterator is an iterator over an Array.
 type Iterator[T any] struct {
 	array *Array[T]
@@ -8,10 +10,27 @@ type Iterator[T any] struct {
 
 // Valid returns if the iterator is
 // currently pointing to a valid element.
-func (it *Iterator[T]) Valid() bool { return it.index < it.array.Size() }
+func (it *Iterator[T]) Valid() bool { return it.index >= 0 && it.index < it.array.Size() }
 
 // Move moves to the next element.
-func (it *Iterator[T]) Move() { it.index++ }
+func (it *Iterator[T]) Move() {
+	if it.Valid() {
+		it.index++
+	}
+}
+
+// MoveBack moves to the previous element.
+func (it *Iterator[T]) MoveBack() {
+	if it.Valid() {
+		it.index--
+	}
+}
+
+// SeekFirst moves to the first element.
+func (it *Iterator[T]) SeekFirst() { it.index = 0 }
+
+// SeekLast moves to the last element.
+func (it *Iterator[T]) SeekLast() { it.index = it.array.Size() - 1 }
 
 // GetRef returns a reference to the current element.
 func (it *Iterator[T]) GetRef() *T { return it.array.GetRef(it.index) }
@@ -39,5 +58,21 @@ func (it *Iterator[T]) InsertAfter(value T) { it.array.Insert(it.index+1, value)
 // Iterator then points to the next element.
 func (it *Iterator[T]) Remove() { it.array.Remove(it.index) }
 
-// Index returns the current index.
-func (it *Iterator[T]) Index() int { return it.index }
+// Seek moves to the element at the specified index.
+func (it *Iterator[T]) Seek(index int) { it.index = index }
+
+// Index returns the current index,
+// or the size of the Array if the iterator is not valid.
+func (it *Iterator[T]) Index() int {
+	if !it.Valid() {
+		return it.array.Size()
+	}
+
+	return it.index
+}
+
+// Distance returns the number of moves needed
+// to get from the current element to the element of the other iterator.
+func (it *Iterator[T]) Distance(other iter.RandomAccessIterator[T]) int {
+	return other.Index() - it.Index()
+}
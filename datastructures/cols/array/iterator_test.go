@@ -0,0 +1,29 @@
+package array
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"slices"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	arr := FromValues(1, 2, 3, 4)
+
+	itertest.CheckBidirectional[int](t, arr.CollectionIterator().(*Iterator[int]), values)
+	itertest.CheckBidirectional[int](t, New[int]().CollectionIterator().(*Iterator[int]), nil)
+
+	itertest.CheckReverseStream(t, arr.ReverseStream, values)
+	itertest.CheckReverseStream2(t, arr.ReverseStream2, []int{0, 1, 2, 3}, values)
+}
+
+func TestSeekIndexDistance(t *testing.T) {
+	values := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	arr := FromSlice(slices.Clone(values))
+	newIterator := func() iter.RandomAccessIterator[int] {
+		return arr.CollectionIterator().(*Iterator[int])
+	}
+
+	itertest.CheckDistance(t, newIterator, values)
+}
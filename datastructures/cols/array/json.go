@@ -0,0 +1,25 @@
+package array
+
+import "encoding/json"
+
+// MarshalJSON encodes the Array as a JSON array.
+func (arr *Array[T]) MarshalJSON() ([]byte, error) {
+	if arr.slice == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(arr.slice)
+}
+
+// UnmarshalJSON replaces the elements of the Array
+// with the elements of the JSON array.
+func (arr *Array[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	arr.slice = slice
+
+	return nil
+}
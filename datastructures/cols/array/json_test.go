@@ -0,0 +1,31 @@
+package array
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	data, err := json.Marshal(FromValues(1, 2, 3))
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := FromValues(9)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := decoded.Slice(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("decoded elements are %v", got)
+	}
+
+	if data, _ := json.Marshal(New[int]()); string(data) != "[]" {
+		t.Errorf("Marshal() of an empty array = %s", data)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": 1}`), decoded); err == nil {
+		t.Error("Unmarshal() of an object succeeded")
+	}
+}
@@ -2,6 +2,7 @@ package bitarray
 
 import (
 	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
 	"slices"
 	"strings"
 )
@@ -283,6 +284,53 @@ func (array *Array) Clone() *Array {
 	}
 }
 
+// Iterator returns a read-only iter.Iterator over the bits.
+//
+// Iteration starts from the first bit.
+func (array *Array) Iterator() iter.Iterator[bool] { return array.ArrayIterator() }
+
+// ArrayIterator returns an Iterator over the bits.
+// It can be used to modify the bits while iterating.
+//
+// Iteration starts from the first bit.
+func (array *Array) ArrayIterator() *Iterator { return &Iterator{array, 0} }
+
+// Stream streams all bits.
+func (array *Array) Stream(yield func(bool) bool) {
+	for i := range array.Size() {
+		if !yield(array.Get(i)) {
+			break
+		}
+	}
+}
+
+// Stream2 streams all bits with their indices.
+func (array *Array) Stream2(yield func(int, bool) bool) {
+	for i := range array.Size() {
+		if !yield(i, array.Get(i)) {
+			break
+		}
+	}
+}
+
+// ReverseStream streams all bits in reverse order.
+func (array *Array) ReverseStream(yield func(bool) bool) {
+	for i := array.Size() - 1; i >= 0; i-- {
+		if !yield(array.Get(i)) {
+			break
+		}
+	}
+}
+
+// ReverseStream2 streams all bits with their indices in reverse order.
+func (array *Array) ReverseStream2(yield func(int, bool) bool) {
+	for i := array.Size() - 1; i >= 0; i-- {
+		if !yield(i, array.Get(i)) {
+			break
+		}
+	}
+}
+
 // String returns a string representation of the Array.
 func (array *Array) String() string {
 	var sb strings.Builder
@@ -0,0 +1,83 @@
+package bitarray
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "bitarray.Array"
+
+// EncodeBinary writes the Array to the writer in the serial format.
+// The bits are written packed, as the number of bits followed by their bytes.
+func (array *Array) EncodeBinary(w io.Writer) error {
+	encoder, err := serial.NewEncoder(w, serial.Header{Kind: binaryKind, Length: 2})
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.Encode(array.Size()); err != nil {
+		return err
+	}
+
+	if err := encoder.Encode(array.slice); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// DecodeBinary replaces the bits of the Array
+// with the ones read from the reader in the serial format.
+func (array *Array) DecodeBinary(r io.Reader) error {
+	decoder, err := serial.NewDecoderOfKind(r, binaryKind)
+	if err != nil {
+		return err
+	}
+
+	var size int
+	if err := decoder.Decode(&size); err != nil {
+		return err
+	}
+
+	var packed []uint8
+	if err := decoder.Decode(&packed); err != nil {
+		return err
+	}
+
+	if size < 0 || len(packed) != (size+7)/8 {
+		return fmt.Errorf("bitarray: %d bytes cannot hold %d bits", len(packed), size)
+	}
+
+	if packed == nil {
+		packed = make([]uint8, 0)
+	}
+
+	if size%8 != 0 {
+		packed[len(packed)-1] &= uint8(0xFF) >> (8 - size%8)
+	}
+
+	array.slice = packed
+	array.lastElemOff = uint8(size % 8)
+
+	return nil
+}
+
+// MarshalBinary encodes the Array in the serial format.
+func (array *Array) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := array.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Array from the serial format.
+func (array *Array) UnmarshalBinary(data []byte) error {
+	return array.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Array in the serial format.
+func (array *Array) GobEncode() ([]byte, error) { return array.MarshalBinary() }
+
+// GobDecode decodes the Array from the serial format.
+func (array *Array) GobDecode(data []byte) error { return array.UnmarshalBinary(data) }
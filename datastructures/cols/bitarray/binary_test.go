@@ -0,0 +1,31 @@
+package bitarray
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"testing"
+)
+
+func TestDecodeBinaryMasksUnusedBits(t *testing.T) {
+	var buf bytes.Buffer
+	encoder, err := serial.NewEncoder(&buf, serial.Header{Kind: binaryKind, Length: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoder.Encode(11)
+	encoder.Encode([]uint8{0xFF, 0xFF})
+
+	array := New()
+	if err := array.DecodeBinary(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if array.Size() != 11 || array.Count() != 11 || !array.All() {
+		t.Fatalf("Size() = %d, Count() = %d", array.Size(), array.Count())
+	}
+
+	if array.slice[1] != 0x07 {
+		t.Errorf("unused bits of the last byte are %08b", array.slice[1])
+	}
+}
@@ -0,0 +1,61 @@
+package bitarray
+
+import "github.com/djordje200179/extendedlibrary/datastructures/iter"
+
+// Iterator is an iterator over an Array.
+type Iterator struct {
+	array *Array
+	index int
+}
+
+// Valid returns if the iterator is
+// currently pointing to a valid bit.
+func (it *Iterator) Valid() bool { return it.index >= 0 && it.index < it.array.Size() }
+
+// Move moves to the next bit.
+func (it *Iterator) Move() {
+	if it.Valid() {
+		it.index++
+	}
+}
+
+// MoveBack moves to the previous bit.
+func (it *Iterator) MoveBack() {
+	if it.Valid() {
+		it.index--
+	}
+}
+
+// SeekFirst moves to the first bit.
+func (it *Iterator) SeekFirst() { it.index = 0 }
+
+// SeekLast moves to the last bit.
+func (it *Iterator) SeekLast() { it.index = it.array.Size() - 1 }
+
+// Get returns the current bit.
+func (it *Iterator) Get() bool { return it.array.Get(it.index) }
+
+// Set sets the current bit.
+func (it *Iterator) Set(value bool) { it.array.Set(it.index, value) }
+
+// Flip flips the current bit.
+func (it *Iterator) Flip() { it.array.Flip(it.index) }
+
+// Seek moves to the bit at the specified index.
+func (it *Iterator) Seek(index int) { it.index = index }
+
+// Index returns the current index,
+// or the size of the Array if the iterator is not valid.
+func (it *Iterator) Index() int {
+	if !it.Valid() {
+		return it.array.Size()
+	}
+
+	return it.index
+}
+
+// Distance returns the number of moves needed
+// to get from the current bit to the bit of the other iterator.
+func (it *Iterator) Distance(other iter.RandomAccessIterator[bool]) int {
+	return other.Index() - it.Index()
+}
@@ -0,0 +1,28 @@
+package bitarray
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	values := []bool{true, false, false, true, true, false, true, true, false, true}
+	array := NewFromSlice(values)
+
+	itertest.CheckBidirectional[bool](t, array.ArrayIterator(), values)
+	itertest.CheckBidirectional[bool](t, New().ArrayIterator(), nil)
+
+	itertest.CheckReverseStream(t, array.ReverseStream, values)
+	itertest.CheckReverseStream2(t, array.ReverseStream2, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, values)
+}
+
+func TestSeekIndexDistance(t *testing.T) {
+	values := []bool{false, false, true, false, false, false, false, false, false, false}
+	array := NewFromSlice(values)
+	newIterator := func() iter.RandomAccessIterator[bool] {
+		return array.ArrayIterator()
+	}
+
+	itertest.CheckDistance(t, newIterator, values)
+}
@@ -0,0 +1,37 @@
+package bitarray
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON encodes the Array as a JSON string
+// of '0' and '1' characters, the same one returned by String.
+func (array *Array) MarshalJSON() ([]byte, error) {
+	return json.Marshal(array.String())
+}
+
+// UnmarshalJSON replaces the bits of the Array
+// with the bits of the JSON string of '0' and '1' characters.
+func (array *Array) UnmarshalJSON(data []byte) error {
+	var bits string
+	if err := json.Unmarshal(data, &bits); err != nil {
+		return err
+	}
+
+	decoded := NewWithCapacity(len(bits))
+	for i := range len(bits) {
+		switch bits[i] {
+		case '0':
+			decoded.Append(false)
+		case '1':
+			decoded.Append(true)
+		default:
+			return fmt.Errorf("bitarray: invalid bit %q at index %d", bits[i], i)
+		}
+	}
+
+	*array = *decoded
+
+	return nil
+}
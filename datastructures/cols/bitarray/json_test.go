@@ -0,0 +1,32 @@
+package bitarray
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	array := NewFromSlice([]bool{true, false, false, true, true})
+
+	data, err := json.Marshal(array)
+	if err != nil || string(data) != `"10011"` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := New()
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.String() != array.String() {
+		t.Errorf("decoded bits are %s", decoded.String())
+	}
+
+	if err := json.Unmarshal([]byte(`"1021"`), decoded); err == nil {
+		t.Error("Unmarshal() of an invalid bit succeeded")
+	}
+
+	if decoded.String() != array.String() {
+		t.Errorf("failed decoding changed the bits to %s", decoded.String())
+	}
+}
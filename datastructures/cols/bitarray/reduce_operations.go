@@ -9,14 +9,14 @@ func (array *Array) All() bool {
 		lastElementMask := uint8(0xFF) >> (8 - array.lastElemOff)
 		lastElement := array.slice[sliceSize-1]
 
-		if lastElement != lastElementMask {
+		if lastElement&lastElementMask != lastElementMask {
 			return false
 		}
 
 		sliceSize--
 	}
 
-	for i := range sliceSize - 1 {
+	for i := range sliceSize {
 		if array.slice[i] != 0xFF {
 			return false
 		}
@@ -39,7 +39,7 @@ func (array *Array) Any() bool {
 		sliceSize--
 	}
 
-	for i := range sliceSize - 1 {
+	for i := range sliceSize {
 		if array.slice[i] != 0 {
 			return true
 		}
@@ -62,7 +62,7 @@ func (array *Array) None() bool {
 		sliceSize--
 	}
 
-	for i := range sliceSize - 1 {
+	for i := range sliceSize {
 		if array.slice[i] != 0 {
 			return false
 		}
@@ -85,7 +85,7 @@ func (array *Array) Count() int {
 		sliceSize--
 	}
 
-	for i := range sliceSize - 1 {
+	for i := range sliceSize {
 		elem := array.slice[i]
 
 		count += bits.OnesCount8(elem)
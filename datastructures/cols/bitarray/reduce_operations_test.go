@@ -0,0 +1,39 @@
+package bitarray
+
+import "testing"
+
+func TestReductions(t *testing.T) {
+	tests := []struct {
+		bits           []bool
+		all, any, none bool
+		count          int
+	}{
+		{[]bool{}, true, false, true, 0},
+		{[]bool{true, true, true, true, true, true, true, true}, true, true, false, 8},
+		{[]bool{false, false, false, false, false, false, false, false, false, true}, false, true, false, 1},
+		{[]bool{true, false, false, false, false, false, false, false, false}, false, true, false, 1},
+		{[]bool{true, true, true, true, true, true, true, false, true, true, true, true, true, true, true, true}, false, true, false, 15},
+		{make([]bool, 17), false, false, true, 0},
+	}
+
+	for _, test := range tests {
+		array := NewFromSlice(test.bits)
+
+		if array.All() != test.all || array.Any() != test.any || array.None() != test.none {
+			t.Errorf("%v: All() = %v, Any() = %v, None() = %v", test.bits, array.All(), array.Any(), array.None())
+		}
+
+		if array.Count() != test.count {
+			t.Errorf("%v: Count() = %d, expected %d", test.bits, array.Count(), test.count)
+		}
+	}
+}
+
+func TestReductionsIgnoreUnusedBits(t *testing.T) {
+	array := NewFromSlice([]bool{true, true, true, true, true, true, true, true, true, true, true})
+	array.slice[1] |= 0xF8
+
+	if !array.All() || array.Count() != 11 {
+		t.Errorf("All() = %v, Count() = %d", array.All(), array.Count())
+	}
+}
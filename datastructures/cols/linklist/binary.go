@@ -0,0 +1,52 @@
+package linklist
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "linklist.List"
+
+// EncodeBinary writes the List to the writer
+// in the serial format, element by element.
+func (list *List[T]) EncodeBinary(w io.Writer) error {
+	return serial.EncodeStream(w, binaryKind, list.size, list.Stream)
+}
+
+// DecodeBinary replaces the elements of the List
+// with the ones read from the reader in the serial format.
+func (list *List[T]) DecodeBinary(r io.Reader) error {
+	var values []T
+	err := serial.DecodeStream(r, binaryKind, func(value T) {
+		values = append(values, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	list.Clear()
+	for _, value := range values {
+		list.Append(value)
+	}
+
+	return nil
+}
+
+// MarshalBinary encodes the List in the serial format.
+func (list *List[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := list.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the List from the serial format.
+func (list *List[T]) UnmarshalBinary(data []byte) error {
+	return list.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the List in the serial format.
+func (list *List[T]) GobEncode() ([]byte, error) { return list.MarshalBinary() }
+
+// GobDecode decodes the List from the serial format.
+func (list *List[T]) GobDecode(data []byte) error { return list.UnmarshalBinary(data) }
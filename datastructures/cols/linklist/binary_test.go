@@ -0,0 +1,40 @@
+package linklist
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	list := New[int]()
+	for i := range 5 {
+		list.Append(i * i)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(list); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := New[int]()
+	decoded.Append(-1)
+	old := decoded.Head()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := elements(decoded); !slices.Equal(got, []int{0, 1, 4, 9, 16}) || decoded.Size() != 5 {
+		t.Errorf("decoded elements are %v", got)
+	}
+
+	if decoded.Tail().list != decoded || old.list != nil {
+		t.Error("decoded nodes belong to the wrong list")
+	}
+
+	data, _ := list.MarshalBinary()
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("UnmarshalBinary() of truncated data succeeded")
+	}
+}
@@ -22,6 +22,25 @@ func (it *Iterator[T]) Move() {
 	it.curr = it.curr.next
 }
 
+// MoveBack moves to the previous element.
+func (it *Iterator[T]) MoveBack() {
+	if it.curr == nil {
+		return
+	}
+
+	it.curr = it.curr.prev
+}
+
+// SeekFirst moves to the first element.
+func (it *Iterator[T]) SeekFirst() {
+	it.curr = it.list.head
+}
+
+// SeekLast moves to the last element.
+func (it *Iterator[T]) SeekLast() {
+	it.curr = it.list.tail
+}
+
 // GetRef returns a reference to the current element.
 func (it *Iterator[T]) GetRef() *T {
 	return &it.curr.Value
@@ -0,0 +1,21 @@
+package linklist
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+
+	list := New[int]()
+	for _, value := range values {
+		list.Append(value)
+	}
+
+	itertest.CheckBidirectional[int](t, list.CollectionIterator().(*Iterator[int]), values)
+	itertest.CheckBidirectional[int](t, New[int]().CollectionIterator().(*Iterator[int]), nil)
+
+	itertest.CheckReverseStream(t, list.ReverseStream, values)
+	itertest.CheckReverseStream2(t, list.ReverseStream2, []int{0, 1, 2, 3}, values)
+}
@@ -0,0 +1,29 @@
+package linklist
+
+import "encoding/json"
+
+// MarshalJSON encodes the List as a JSON array.
+func (list *List[T]) MarshalJSON() ([]byte, error) {
+	slice := make([]T, 0, list.size)
+	for value := range list.Stream {
+		slice = append(slice, value)
+	}
+
+	return json.Marshal(slice)
+}
+
+// UnmarshalJSON replaces the elements of the List
+// with the elements of the JSON array.
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	list.Clear()
+	for _, value := range slice {
+		list.Append(value)
+	}
+
+	return nil
+}
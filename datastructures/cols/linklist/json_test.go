@@ -0,0 +1,32 @@
+package linklist
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	list := New[string]()
+	list.Append("a")
+	list.Append("b")
+
+	data, err := json.Marshal(list)
+	if err != nil || string(data) != `["a","b"]` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	var decoded List[string]
+	decoded.Append("old")
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := slices.Collect(decoded.Stream); !slices.Equal(got, []string{"a", "b"}) || decoded.Size() != 2 {
+		t.Errorf("decoded elements are %q", got)
+	}
+
+	if data, _ := json.Marshal(New[int]()); string(data) != "[]" {
+		t.Errorf("Marshal() of an empty list = %s", data)
+	}
+}
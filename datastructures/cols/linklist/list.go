@@ -137,9 +137,7 @@ func (list *List[T]) Remove(index int) {
 //
 // Panic occurs if the node is not associated with the List.
 func (list *List[T]) RemoveNode(node *Node[T]) {
-	if node == nil || node.list != list {
-		panic("invalid node")
-	}
+	list.checkNode(node)
 
 	if node.prev != nil {
 		node.prev.next = node.next
@@ -153,11 +151,22 @@ func (list *List[T]) RemoveNode(node *Node[T]) {
 		list.tail = node.prev
 	}
 
+	node.list = nil
+	node.prev = nil
+	node.next = nil
+
 	list.size--
 }
 
 // Clear removes all elements.
+//
+// The nodes are walked to detach them from the List,
+// so it takes O(n) time.
 func (list *List[T]) Clear() {
+	for curr := list.head; curr != nil; curr = curr.next {
+		curr.list = nil
+	}
+
 	list.head = nil
 	list.tail = nil
 	list.size = 0
@@ -175,22 +184,19 @@ func (list *List[T]) Reverse() {
 // Join moves all elements from the other cols.Collection
 // to the end. The other cols.Collection becomes empty.
 //
-// If the other collection is a List, only pointers to the nodes are moved.
-// Unfortunately, it is also needed to update the reference
-// to the list in the moved nodes.
-// If the other collection is not a List, the elements are moved one by one.
+// If the other collection is a List, only pointers to the nodes are moved,
+// but the nodes are still walked to associate them with the List.
+// Otherwise, the elements are moved one by one.
 func (list *List[T]) Join(other cols.Collection[T]) {
 	switch second := other.(type) {
 	case *List[T]:
-		for curr := second.head; curr != nil; curr = curr.next {
-			curr.list = list
+		if second == list {
+			return
 		}
 
-		list.tail.next = second.head
-		second.head.prev = list.tail
-		list.tail = second.tail
-
-		list.size += second.size
+		if second.size > 0 {
+			list.Splice(nil, second, second.head, second.tail)
+		}
 	default:
 		for it := other.Iterator(); it.Valid(); it.Move() {
 			list.Append(it.Get())
@@ -250,6 +256,28 @@ func (list *List[T]) Stream2(yield func(int, T) bool) {
 	}
 }
 
+// ReverseStream streams all elements in reverse order.
+//
+// It is safe to modify the List while streaming.
+func (list *List[T]) ReverseStream(yield func(T) bool) {
+	for curr := list.tail; curr != nil; curr = curr.prev {
+		if !yield(curr.Value) {
+			return
+		}
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+//
+// It is safe to modify the List while streaming.
+func (list *List[T]) ReverseStream2(yield func(int, T) bool) {
+	for curr, i := list.tail, list.size-1; curr != nil; curr, i = curr.prev, i-1 {
+		if !yield(i, curr.Value) {
+			return
+		}
+	}
+}
+
 // FindIndex returns the index of the first element
 // that satisfies the specified predicate.
 // If no such element is found, 0 and false are returned.
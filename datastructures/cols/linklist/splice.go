@@ -0,0 +1,170 @@
+package linklist
+
+func (list *List[T]) checkNode(node *Node[T]) {
+	if node == nil || node.list != list {
+		panic("invalid node")
+	}
+}
+
+// unlink detaches the nodes from first to last,
+// which are count nodes in total, from the List.
+func (list *List[T]) unlink(first, last *Node[T], count int) {
+	if first.prev != nil {
+		first.prev.next = last.next
+	} else {
+		list.head = last.next
+	}
+
+	if last.next != nil {
+		last.next.prev = first.prev
+	} else {
+		list.tail = first.prev
+	}
+
+	first.prev = nil
+	last.next = nil
+
+	list.size -= count
+}
+
+// link attaches the detached nodes from first to last,
+// which are count nodes in total, before the specified node.
+// If the node is nil, they are attached to the end.
+func (list *List[T]) link(at, first, last *Node[T], count int) {
+	prev := list.tail
+	if at != nil {
+		prev = at.prev
+	}
+
+	first.prev = prev
+	last.next = at
+
+	if prev != nil {
+		prev.next = first
+	} else {
+		list.head = first
+	}
+
+	if at != nil {
+		at.prev = last
+	} else {
+		list.tail = last
+	}
+
+	list.size += count
+}
+
+// MoveToFront moves the specified node to the beginning.
+//
+// Panic occurs if the node is not associated with the List.
+func (list *List[T]) MoveToFront(node *Node[T]) {
+	list.checkNode(node)
+
+	if node == list.head {
+		return
+	}
+
+	list.unlink(node, node, 1)
+	list.link(list.head, node, node, 1)
+}
+
+// MoveToBack moves the specified node to the end.
+//
+// Panic occurs if the node is not associated with the List.
+func (list *List[T]) MoveToBack(node *Node[T]) {
+	list.checkNode(node)
+
+	if node == list.tail {
+		return
+	}
+
+	list.unlink(node, node, 1)
+	list.link(nil, node, node, 1)
+}
+
+// MoveBefore moves the specified node before the mark node.
+//
+// Panic occurs if any of the nodes is not associated with the List.
+func (list *List[T]) MoveBefore(node, mark *Node[T]) {
+	list.checkNode(node)
+	list.checkNode(mark)
+
+	if node == mark {
+		return
+	}
+
+	list.unlink(node, node, 1)
+	list.link(mark, node, node, 1)
+}
+
+// MoveAfter moves the specified node after the mark node.
+//
+// Panic occurs if any of the nodes is not associated with the List.
+func (list *List[T]) MoveAfter(node, mark *Node[T]) {
+	list.checkNode(node)
+	list.checkNode(mark)
+
+	if node == mark {
+		return
+	}
+
+	list.unlink(node, node, 1)
+	list.link(mark.next, node, node, 1)
+}
+
+// Splice moves the nodes from the first to the last one (inclusive)
+// from the other List and inserts them before the specified node.
+// If the node is nil, they are inserted at the end.
+//
+// Only pointers to the nodes are moved, so no element is reallocated.
+// The moved nodes are walked to check the range
+// and to associate them with the List,
+// so it takes O(k) time for k moved nodes.
+//
+// Panic occurs if the nodes are not associated with their Lists,
+// if the last node is not after the first one
+// or if the node is inside the moved range.
+func (list *List[T]) Splice(at *Node[T], other *List[T], first, last *Node[T]) {
+	if at != nil {
+		list.checkNode(at)
+	}
+	other.checkNode(first)
+	other.checkNode(last)
+
+	count := 1
+	for curr := first; curr != last; count++ {
+		if curr == at {
+			panic("node is inside the moved range")
+		}
+
+		curr = curr.next
+		if curr == nil {
+			panic("last node is not after the first one")
+		}
+	}
+
+	if last == at {
+		panic("node is inside the moved range")
+	}
+
+	for curr := first; curr != last.next; curr = curr.next {
+		curr.list = list
+	}
+
+	other.unlink(first, last, count)
+	list.link(at, first, last, count)
+}
+
+// SplitAt moves the specified node and all nodes
+// after it to a new List, which is returned.
+// It takes O(k) time for k moved nodes.
+//
+// Panic occurs if the node is not associated with the List.
+func (list *List[T]) SplitAt(node *Node[T]) *List[T] {
+	list.checkNode(node)
+
+	split := New[T]()
+	split.Splice(nil, list, node, list.tail)
+
+	return split
+}
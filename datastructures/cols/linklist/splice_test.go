@@ -0,0 +1,238 @@
+package linklist
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func elements[T any](list *List[T]) []T {
+	var elements []T
+	for value := range list.Stream {
+		elements = append(elements, value)
+	}
+
+	return elements
+}
+
+func TestSpliceAndSplit(t *testing.T) {
+	first, second := New[int](), New[int]()
+	for i := range 5 {
+		first.Append(i)
+		second.Append(10 + i)
+	}
+
+	first.MoveToFront(first.Tail())
+	first.MoveAfter(first.Head(), first.Tail())
+	if got := elements(first); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("moved elements are %v", got)
+	}
+
+	first.Splice(first.Head().Next(), second, second.Head().Next(), second.Tail().Prev())
+	if got := elements(first); !slices.Equal(got, []int{0, 11, 12, 13, 1, 2, 3, 4}) {
+		t.Fatalf("spliced elements are %v", got)
+	}
+
+	if got := elements(second); !slices.Equal(got, []int{10, 14}) || second.Size() != 2 {
+		t.Fatalf("remaining elements are %v", got)
+	}
+
+	rest := first.SplitAt(first.GetNode(4))
+	if first.Size() != 4 || rest.Size() != 4 || rest.Head().list != rest {
+		t.Fatal("split lists have wrong sizes")
+	}
+
+	empty := New[int]()
+	empty.Join(rest)
+	if got := elements(empty); !slices.Equal(got, []int{1, 2, 3, 4}) || rest.Size() != 0 {
+		t.Fatalf("joined elements are %v", got)
+	}
+}
+
+func TestSpliceOwnership(t *testing.T) {
+	first, second := New[int](), New[int]()
+	for i := range 6 {
+		first.Append(i)
+		second.Append(10 + i)
+	}
+
+	first.Join(second)
+	if second.Head() != nil || second.Size() != 0 || first.GetNode(6).list != first {
+		t.Fatal("joined nodes belong to the other list")
+	}
+
+	first.Splice(first.Head(), first, first.GetNode(8), first.Tail())
+	split := first.SplitAt(first.GetNode(1))
+	first.Splice(nil, split, split.Head().Next(), split.Tail().Prev())
+	first.Splice(nil, split, split.Head(), split.Tail())
+
+	want := []int{12, 14, 15, 0, 1, 2, 3, 4, 5, 10, 13, 11}
+	if got := elements(first); !slices.Equal(got, want) || first.Size() != len(want) || split.Size() != 0 {
+		t.Fatalf("elements are %v", got)
+	}
+
+	for node := first.Head(); node != nil; node = node.Next() {
+		if node.list != first {
+			t.Fatalf("node %d belongs to another list", node.Value)
+		}
+	}
+}
+
+func checkPanics(t *testing.T, name string, f func()) {
+	t.Helper()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s didn't panic", name)
+		}
+	}()
+
+	f()
+}
+
+func TestRemovedNodes(t *testing.T) {
+	list := New[int]()
+	for i := range 5 {
+		list.Append(i)
+	}
+
+	removed := list.GetNode(2)
+	list.RemoveNode(removed)
+	checkPanics(t, "MoveToFront() of a removed node", func() { list.MoveToFront(removed) })
+	checkPanics(t, "RemoveNode() of a removed node", func() { list.RemoveNode(removed) })
+	if got := elements(list); !slices.Equal(got, []int{0, 1, 3, 4}) || list.Size() != 4 {
+		t.Fatalf("elements are %v", got)
+	}
+
+	cleared := list.Head()
+	list.Clear()
+	list.Append(9)
+	checkPanics(t, "MoveToBack() of a cleared node", func() { list.MoveToBack(cleared) })
+	if got := elements(list); !slices.Equal(got, []int{9}) || list.Size() != 1 {
+		t.Fatalf("elements after clearing are %v", got)
+	}
+}
+
+func TestRemovedNodeAfterTransfer(t *testing.T) {
+	source, target := New[int](), New[int]()
+	for i := range 10 {
+		source.Append(i)
+	}
+	target.Append(-1)
+
+	removed := source.Head()
+	source.RemoveNode(removed)
+
+	source.Splice(nil, source, source.Head(), source.Head())
+	target.Splice(nil, source, source.Head(), source.GetNode(-2))
+
+	if source.Size() != 1 || target.Size() != 9 {
+		t.Fatalf("sizes are %d and %d", source.Size(), target.Size())
+	}
+
+	checkPanics(t, "MoveToBack() of a node removed before the transfer", func() { target.MoveToBack(removed) })
+	checkPanics(t, "MoveToBack() of a node left in the source", func() { target.MoveToBack(source.Head()) })
+	if got := elements(target); !slices.Equal(got, []int{-1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Fatalf("elements are %v", got)
+	}
+}
+
+func TestSpliceInvalidRanges(t *testing.T) {
+	first, second := New[int](), New[int]()
+	for i := range 5 {
+		first.Append(i)
+		second.Append(10 + i)
+	}
+
+	checkPanics(t, "Splice() of a reversed range", func() { first.Splice(nil, first, first.GetNode(3), first.GetNode(1)) })
+	checkPanics(t, "Splice() of a reversed range from another list", func() { first.Splice(nil, second, second.Tail(), second.Head()) })
+	checkPanics(t, "Splice() before a node inside the range", func() { first.Splice(first.GetNode(2), first, first.GetNode(1), first.GetNode(3)) })
+	checkPanics(t, "Splice() before the last node of the range", func() { first.Splice(first.GetNode(3), first, first.GetNode(1), first.GetNode(3)) })
+	checkPanics(t, "Splice() of nodes from another list", func() { first.Splice(nil, first, second.Head(), second.Tail()) })
+
+	for list, want := range map[*List[int]][]int{first: {0, 1, 2, 3, 4}, second: {10, 11, 12, 13, 14}} {
+		if got := elements(list); !slices.Equal(got, want) || list.Size() != len(want) {
+			t.Fatalf("elements after failed splices are %v", got)
+		}
+
+		for node := list.Head(); node != nil; node = node.Next() {
+			if node.list != list {
+				t.Fatalf("node %d belongs to another list", node.Value)
+			}
+		}
+	}
+}
+
+func TestRandomSplices(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	lists := make([]*List[int], 4)
+	expected := make([][]int, len(lists))
+	for i := range lists {
+		lists[i] = New[int]()
+		for j := range 10 {
+			lists[i].Append(i*100 + j)
+			expected[i] = append(expected[i], i*100+j)
+		}
+	}
+
+	for step := range 3000 {
+		target, source := random.Intn(len(lists)), random.Intn(len(lists))
+		list, other := lists[target], lists[source]
+
+		switch op := random.Intn(6); {
+		case op < 3 && len(expected[source]) > 0:
+			begin := random.Intn(len(expected[source]))
+			end := begin + random.Intn(len(expected[source])-begin)
+			moved := slices.Clone(expected[source][begin : end+1])
+
+			rest := slices.Delete(slices.Clone(expected[source]), begin, end+1)
+			if target == source && len(rest) == 0 {
+				continue
+			}
+
+			destination := rest
+			if target != source {
+				destination = expected[target]
+			}
+
+			index := random.Intn(len(destination) + 1)
+			var at *Node[int]
+			if index < len(destination) {
+				at = list.GetNode(slices.Index(expected[target], destination[index]))
+			}
+
+			list.Splice(at, other, other.GetNode(begin), other.GetNode(end))
+
+			expected[source] = rest
+			expected[target] = slices.Insert(slices.Clone(destination), index, moved...)
+		case op == 3 && len(expected[target]) > 0:
+			index := random.Intn(len(expected[target]))
+			list.RemoveNode(list.GetNode(index))
+			expected[target] = slices.Delete(expected[target], index, index+1)
+		case op == 4 && len(expected[target]) > 0:
+			index := random.Intn(len(expected[target]))
+			list.GetNode(index).InsertBefore(step + 1000)
+			expected[target] = slices.Insert(expected[target], index, step+1000)
+		case op == 5:
+			list.Reverse()
+			slices.Reverse(expected[target])
+		}
+
+		for i, list := range lists {
+			if got := elements(list); !slices.Equal(got, expected[i]) || list.Size() != len(expected[i]) {
+				t.Fatalf("step %d: list %d has %v with size %d, expected %v", step, i, got, list.Size(), expected[i])
+			}
+		}
+
+		if step%10 == 0 {
+			for i, list := range lists {
+				for node := list.Head(); node != nil; node = node.Next() {
+					if node.list != list {
+						t.Fatalf("step %d: node %d of list %d belongs to another list", step, node.Value, i)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package pvector
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+)
+
+// Iterator is an iterator over a Vector.
+//
+// It remembers the leaf of the current element,
+// so moving to the neighbouring elements takes constant time.
+// Vectors are immutable, so the iterator
+// is not affected by newer versions of the Vector.
+type Iterator[T any] struct {
+	vector Vector[T]
+	index  int
+
+	leaf      []T
+	leafStart int
+}
+
+// Valid returns if the iterator is
+// currently pointing to a valid element.
+func (it *Iterator[T]) Valid() bool { return it.index >= 0 && it.index < it.vector.size }
+
+// Move moves to the next element.
+func (it *Iterator[T]) Move() {
+	if it.Valid() {
+		it.index++
+	}
+}
+
+// MoveBack moves to the previous element.
+func (it *Iterator[T]) MoveBack() {
+	if it.Valid() {
+		it.index--
+	}
+}
+
+// SeekFirst moves to the first element.
+func (it *Iterator[T]) SeekFirst() { it.index = 0 }
+
+// SeekLast moves to the last element.
+func (it *Iterator[T]) SeekLast() { it.index = it.vector.size - 1 }
+
+// Get returns the current element.
+func (it *Iterator[T]) Get() T {
+	if it.leaf == nil || it.index < it.leafStart || it.index >= it.leafStart+len(it.leaf) {
+		if !it.Valid() {
+			panic(cols.IndexOutOfBoundsError{Index: it.index, Length: it.vector.size})
+		}
+
+		it.leaf, it.leafStart = it.vector.leafFor(it.index)
+	}
+
+	return it.leaf[it.index-it.leafStart]
+}
+
+// Seek moves to the element at the specified index.
+func (it *Iterator[T]) Seek(index int) { it.index = index }
+
+// Index returns the current index,
+// or the size of the Vector if the iterator is not valid.
+func (it *Iterator[T]) Index() int {
+	if !it.Valid() {
+		return it.vector.size
+	}
+
+	return it.index
+}
+
+// Distance returns the number of moves needed
+// to get from the current element to the element of the other iterator.
+func (it *Iterator[T]) Distance(other iter.RandomAccessIterator[T]) int {
+	return other.Index() - it.Index()
+}
@@ -0,0 +1,34 @@
+package pvector
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		name     string
+		vector   Vector[int]
+		expected []int
+	}{
+		{"empty", New[int](), nil},
+		{"single element", FromSlice(itertest.Squares(1)), itertest.Squares(1)},
+		{"full tail", FromSlice(itertest.Squares(width)), itertest.Squares(width)},
+		{"first leaf in the trie", FromSlice(itertest.Squares(width + 1)), itertest.Squares(width + 1)},
+		{"appended past the tail", FromSlice(itertest.Squares(width)).Append(width * width), itertest.Squares(width + 1)},
+		{"tail taken from the trie", FromSlice(itertest.Squares(width + 1)).RemoveLast(), itertest.Squares(width)},
+		{"full root", FromSlice(itertest.Squares(width*width + width)), itertest.Squares(width*width + width)},
+		{"grown root", FromSlice(itertest.Squares(width*width + width + 1)), itertest.Squares(width*width + width + 1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newIterator := func() iter.BidirectionalIterator[int] {
+				return test.vector.VectorIterator()
+			}
+
+			itertest.CheckSequence(t, test.vector, newIterator, test.expected)
+		})
+	}
+}
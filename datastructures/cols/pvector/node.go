@@ -0,0 +1,120 @@
+package pvector
+
+import "slices"
+
+const (
+	bits  = 5
+	width = 1 << bits
+	mask  = width - 1
+)
+
+// token marks the nodes that a Transient is allowed to modify in place.
+type token struct{ _ byte }
+
+// node is either an internal node with children
+// or a leaf with exactly width values.
+type node[T any] struct {
+	children []*node[T]
+	values   []T
+
+	owner *token
+}
+
+func (n *node[T]) clone(owner *token) *node[T] {
+	return &node[T]{
+		children: slices.Clone(n.children),
+		values:   slices.Clone(n.values),
+		owner:    owner,
+	}
+}
+
+// editable returns the node itself if it is owned by the token,
+// or its copy owned by the token otherwise.
+// A nil token means that the node is never modified in place.
+func (n *node[T]) editable(owner *token) *node[T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+
+	return n.clone(owner)
+}
+
+func newPath[T any](level uint, leaf *node[T], owner *token) *node[T] {
+	if level == 0 {
+		return leaf
+	}
+
+	return &node[T]{
+		children: []*node[T]{newPath(level-bits, leaf, owner)},
+		owner:    owner,
+	}
+}
+
+// pushTail adds the full tail leaf as the last leaf
+// of a trie that holds size elements in total.
+func pushTail[T any](size int, level uint, parent, leaf *node[T], owner *token) *node[T] {
+	result := parent.editable(owner)
+	index := ((size - 1) >> level) & mask
+
+	var child *node[T]
+	switch {
+	case level == bits:
+		child = leaf
+	case index < len(parent.children):
+		child = pushTail(size, level-bits, parent.children[index], leaf, owner)
+	default:
+		child = newPath(level-bits, leaf, owner)
+	}
+
+	if index < len(result.children) {
+		result.children[index] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+
+	return result
+}
+
+// popTail removes the last leaf of a trie that holds
+// size elements in total, or returns nil if the trie becomes empty.
+func popTail[T any](size int, level uint, n *node[T], owner *token) *node[T] {
+	index := ((size - 2) >> level) & mask
+
+	if level > bits {
+		child := popTail(size, level-bits, n.children[index], owner)
+		if child == nil && index == 0 {
+			return nil
+		}
+
+		result := n.editable(owner)
+		if child == nil {
+			result.children = result.children[:index]
+		} else {
+			result.children[index] = child
+		}
+
+		return result
+	}
+
+	if index == 0 {
+		return nil
+	}
+
+	result := n.editable(owner)
+	result.children = result.children[:index]
+
+	return result
+}
+
+func set[T any](level uint, n *node[T], index int, value T, owner *token) *node[T] {
+	result := n.editable(owner)
+
+	if level == 0 {
+		result.values[index&mask] = value
+	} else {
+		child := (index >> level) & mask
+		result.children[child] = set(level-bits, n.children[child], index, value, owner)
+	}
+
+	return result
+}
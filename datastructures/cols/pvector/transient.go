@@ -0,0 +1,109 @@
+package pvector
+
+import "github.com/djordje200179/extendedlibrary/datastructures/cols"
+
+// Transient is a mutable builder of a Vector.
+//
+// It modifies in place the nodes it has already copied,
+// so a batch of modifications is much faster than
+// the same modifications of a Vector. Vectors that
+// the Transient was created from are not affected by it.
+//
+// Call Persistent to get the resulting Vector.
+// The Transient can't be used afterward.
+// It is not safe to use a Transient concurrently.
+type Transient[T any] struct {
+	root  *node[T]
+	tail  []T
+	size  int
+	shift uint
+
+	owner *token
+}
+
+func (transient *Transient[T]) ensureActive() {
+	if transient.owner == nil {
+		panic("transient used after Persistent")
+	}
+}
+
+// Size returns the number of elements.
+func (transient *Transient[T]) Size() int { return transient.size }
+
+// Get returns the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (transient *Transient[T]) Get(index int) T {
+	transient.ensureActive()
+	index = getRealIndex(index, transient.size)
+
+	leaf, start := leafFor(transient.root, transient.tail, transient.size, transient.shift, index)
+	return leaf[index-start]
+}
+
+// Set sets the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (transient *Transient[T]) Set(index int, value T) {
+	transient.ensureActive()
+	index = getRealIndex(index, transient.size)
+
+	if offset := tailOffset(transient.size); index >= offset {
+		transient.tail[index-offset] = value
+	} else {
+		transient.root = set(transient.shift, transient.root, index, value, transient.owner)
+	}
+}
+
+// Append appends the specified element to the end.
+func (transient *Transient[T]) Append(value T) {
+	transient.ensureActive()
+
+	if transient.size-tailOffset(transient.size) == width {
+		leaf := &node[T]{values: transient.tail, owner: transient.owner}
+		transient.root, transient.shift = pushLeaf(transient.root, transient.size, transient.shift, leaf, transient.owner)
+		transient.tail = make([]T, 0, width)
+	}
+
+	transient.tail = append(transient.tail, value)
+	transient.size++
+}
+
+// RemoveLast removes the last element.
+//
+// Panic occurs if the Transient is empty.
+func (transient *Transient[T]) RemoveLast() {
+	transient.ensureActive()
+
+	if transient.size == 0 {
+		panic(cols.IndexOutOfBoundsError{Index: -1, Length: 0})
+	}
+
+	if len(transient.tail) > 1 || transient.size == 1 {
+		transient.tail = transient.tail[:len(transient.tail)-1]
+		transient.size--
+
+		return
+	}
+
+	leaf, _ := leafFor(transient.root, transient.tail, transient.size, transient.shift, transient.size-2)
+	transient.tail = append(make([]T, 0, width), leaf...)
+	transient.root, transient.shift = popLeaf(transient.root, transient.size, transient.shift, transient.owner)
+	transient.size--
+}
+
+// Persistent returns a Vector with the elements of the Transient
+// and ends the Transient, so it can't be modified anymore.
+func (transient *Transient[T]) Persistent() Vector[T] {
+	transient.ensureActive()
+	transient.owner = nil
+
+	return Vector[T]{
+		root:  transient.root,
+		tail:  transient.tail[:len(transient.tail):len(transient.tail)],
+		size:  transient.size,
+		shift: transient.shift,
+	}
+}
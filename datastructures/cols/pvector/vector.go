@@ -0,0 +1,290 @@
+package pvector
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"slices"
+)
+
+// Vector is a persistent (immutable) indexed sequence
+// based on a 32-way trie with a separate tail leaf.
+//
+// Every modification returns a new version of the Vector
+// and leaves the original one unchanged.
+// Versions share all nodes that were not on the path
+// of the modification, so Get, Set, Append and RemoveLast
+// take O(log32 n) time. It is safe to use any version
+// concurrently from multiple goroutines.
+//
+// For building a Vector with many modifications,
+// use a Transient.
+//
+// The zero value is an empty Vector.
+type Vector[T any] struct {
+	root  *node[T]
+	tail  []T
+	size  int
+	shift uint
+}
+
+// New creates an empty Vector.
+func New[T any]() Vector[T] { return Vector[T]{} }
+
+// NewFromIterable creates a Vector from the specified iter.Iterable.
+func NewFromIterable[T any](iterable iter.Iterable[T]) Vector[T] {
+	transient := New[T]().Transient()
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		transient.Append(it.Get())
+	}
+
+	return transient.Persistent()
+}
+
+// FromSlice creates a Vector from the specified slice.
+// The elements are copied, so the slice can be modified afterward.
+func FromSlice[T any](slice []T) Vector[T] {
+	transient := New[T]().Transient()
+	for _, value := range slice {
+		transient.Append(value)
+	}
+
+	return transient.Persistent()
+}
+
+// FromValues creates a Vector from the specified values.
+func FromValues[T any](values ...T) Vector[T] { return FromSlice(values) }
+
+// Size returns the number of elements.
+func (vector Vector[T]) Size() int { return vector.size }
+
+func getRealIndex(index, size int) int {
+	if index >= size || index < -size {
+		panic(cols.IndexOutOfBoundsError{Index: index, Length: size})
+	}
+
+	if index < 0 {
+		index += size
+	}
+
+	return index
+}
+
+func tailOffset(size int) int {
+	if size < width {
+		return 0
+	}
+
+	return (size - 1) &^ mask
+}
+
+// leafFor returns the values of the leaf that holds
+// the element at the specified index and the index of its first element.
+func leafFor[T any](root *node[T], tail []T, size int, shift uint, index int) ([]T, int) {
+	if offset := tailOffset(size); index >= offset {
+		return tail, offset
+	}
+
+	curr := root
+	for level := shift; level > 0; level -= bits {
+		curr = curr.children[(index>>level)&mask]
+	}
+
+	return curr.values, index &^ mask
+}
+
+func (vector Vector[T]) leafFor(index int) ([]T, int) {
+	return leafFor(vector.root, vector.tail, vector.size, vector.shift, index)
+}
+
+// Get returns the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (vector Vector[T]) Get(index int) T {
+	index = getRealIndex(index, vector.size)
+
+	leaf, start := vector.leafFor(index)
+	return leaf[index-start]
+}
+
+// Set returns a new version of the Vector in which
+// the element at the specified index is replaced.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (vector Vector[T]) Set(index int, value T) Vector[T] {
+	index = getRealIndex(index, vector.size)
+
+	if offset := tailOffset(vector.size); index >= offset {
+		tail := slices.Clone(vector.tail)
+		tail[index-offset] = value
+		vector.tail = tail
+	} else {
+		vector.root = set(vector.shift, vector.root, index, value, nil)
+	}
+
+	return vector
+}
+
+// Append returns a new version of the Vector
+// with the specified element added to the end.
+func (vector Vector[T]) Append(value T) Vector[T] {
+	if vector.size-tailOffset(vector.size) < width {
+		vector.tail = append(vector.tail[:len(vector.tail):len(vector.tail)], value)
+		vector.size++
+
+		return vector
+	}
+
+	vector.root, vector.shift = pushLeaf(vector.root, vector.size, vector.shift, &node[T]{values: vector.tail}, nil)
+	vector.tail = []T{value}
+	vector.size++
+
+	return vector
+}
+
+// pushLeaf adds the full tail leaf to the trie,
+// adding a new root level if the trie is full.
+func pushLeaf[T any](root *node[T], size int, shift uint, leaf *node[T], owner *token) (*node[T], uint) {
+	if root == nil {
+		return &node[T]{children: []*node[T]{leaf}, owner: owner}, bits
+	}
+
+	if size>>bits > 1<<shift {
+		root = &node[T]{
+			children: []*node[T]{root, newPath(shift, leaf, owner)},
+			owner:    owner,
+		}
+
+		return root, shift + bits
+	}
+
+	return pushTail(size, shift, root, leaf, owner), shift
+}
+
+// popLeaf removes the last leaf from the trie,
+// removing the root level if it has only one child.
+func popLeaf[T any](root *node[T], size int, shift uint, owner *token) (*node[T], uint) {
+	root = popTail(size, shift, root, owner)
+
+	switch {
+	case root == nil:
+		return nil, 0
+	case shift > bits && len(root.children) == 1:
+		return root.children[0], shift - bits
+	default:
+		return root, shift
+	}
+}
+
+// RemoveLast returns a new version of the Vector
+// without the last element.
+//
+// Panic occurs if the Vector is empty.
+func (vector Vector[T]) RemoveLast() Vector[T] {
+	if vector.size == 0 {
+		panic(cols.IndexOutOfBoundsError{Index: -1, Length: 0})
+	}
+
+	if len(vector.tail) > 1 || vector.size == 1 {
+		vector.tail = vector.tail[: len(vector.tail)-1 : len(vector.tail)-1]
+		vector.size--
+
+		return vector
+	}
+
+	vector.tail, _ = vector.leafFor(vector.size - 2)
+	vector.root, vector.shift = popLeaf(vector.root, vector.size, vector.shift, nil)
+	vector.size--
+
+	return vector
+}
+
+// Clear returns an empty Vector.
+func (vector Vector[T]) Clear() Vector[T] { return Vector[T]{} }
+
+// Transient returns a Transient that starts
+// with the elements of the Vector.
+// The Vector itself is not affected by it.
+func (vector Vector[T]) Transient() *Transient[T] {
+	tail := make([]T, len(vector.tail), width)
+	copy(tail, vector.tail)
+
+	return &Transient[T]{
+		root:  vector.root,
+		tail:  tail,
+		size:  vector.size,
+		shift: vector.shift,
+		owner: new(token),
+	}
+}
+
+// Iterator returns an iter.Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (vector Vector[T]) Iterator() iter.Iterator[T] { return vector.VectorIterator() }
+
+// VectorIterator returns an Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (vector Vector[T]) VectorIterator() *Iterator[T] { return &Iterator[T]{vector: vector} }
+
+// Stream streams all elements.
+func (vector Vector[T]) Stream(yield func(T) bool) {
+	for start := 0; start < vector.size; start += width {
+		leaf, _ := vector.leafFor(start)
+		for _, value := range leaf {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Stream2 streams all elements with their indices.
+func (vector Vector[T]) Stream2(yield func(int, T) bool) {
+	for start := 0; start < vector.size; start += width {
+		leaf, _ := vector.leafFor(start)
+		for i, value := range leaf {
+			if !yield(start+i, value) {
+				return
+			}
+		}
+	}
+}
+
+// ReverseStream streams all elements in reverse order.
+func (vector Vector[T]) ReverseStream(yield func(T) bool) {
+	for _, value := range vector.ReverseStream2 {
+		if !yield(value) {
+			return
+		}
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+func (vector Vector[T]) ReverseStream2(yield func(int, T) bool) {
+	if vector.size == 0 {
+		return
+	}
+
+	for start := tailOffset(vector.size); start >= 0; start -= width {
+		leaf, _ := vector.leafFor(start)
+		for i := len(leaf) - 1; i >= 0; i-- {
+			if !yield(start+i, leaf[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a new slice of all elements.
+func (vector Vector[T]) Slice() []T {
+	slice := make([]T, 0, vector.size)
+	for start := 0; start < vector.size; start += width {
+		leaf, _ := vector.leafFor(start)
+		slice = append(slice, leaf...)
+	}
+
+	return slice
+}
@@ -0,0 +1,51 @@
+package pvector
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestVersionsAreIndependent(t *testing.T) {
+	const size = 5000
+
+	var versions []Vector[int]
+
+	vector := New[int]()
+	for i := range size {
+		versions = append(versions, vector)
+		vector = vector.Append(i)
+	}
+
+	changed := vector.Set(size/2, -1)
+	transient := vector.Transient()
+	for range size / 2 {
+		transient.RemoveLast()
+	}
+	transient.Set(0, -2)
+	shrunk := transient.Persistent()
+
+	for i, version := range versions {
+		if version.Size() != i {
+			t.Fatalf("version %d has size %d", i, version.Size())
+		}
+	}
+
+	expected := make([]int, size)
+	for i := range expected {
+		expected[i] = i
+	}
+
+	if !slices.Equal(vector.Slice(), expected) {
+		t.Fatal("original vector was modified")
+	}
+
+	if changed.Get(size/2) != -1 || changed.Get(size/2+1) != size/2+1 {
+		t.Fatal("set vector has wrong elements")
+	}
+
+	expected = expected[:size/2]
+	expected[0] = -2
+	if !slices.Equal(shrunk.Slice(), expected) {
+		t.Fatal("transient produced wrong elements")
+	}
+}
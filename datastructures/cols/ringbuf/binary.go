@@ -0,0 +1,51 @@
+package ringbuf
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "ringbuf.Buffer"
+
+// EncodeBinary writes the Buffer to the writer
+// in the serial format, element by element.
+func (buf *Buffer[T]) EncodeBinary(w io.Writer) error {
+	return serial.EncodeStream(w, binaryKind, buf.size, buf.Stream)
+}
+
+// DecodeBinary replaces the elements of the Buffer
+// with the ones read from the reader in the serial format.
+func (buf *Buffer[T]) DecodeBinary(r io.Reader) error {
+	var slice []T
+	err := serial.DecodeStream(r, binaryKind, func(value T) {
+		slice = append(slice, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	buf.slice = slice
+	buf.head = 0
+	buf.size = len(slice)
+
+	return nil
+}
+
+// MarshalBinary encodes the Buffer in the serial format.
+func (buf *Buffer[T]) MarshalBinary() ([]byte, error) {
+	var encoded bytes.Buffer
+	err := buf.EncodeBinary(&encoded)
+	return encoded.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Buffer from the serial format.
+func (buf *Buffer[T]) UnmarshalBinary(data []byte) error {
+	return buf.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Buffer in the serial format.
+func (buf *Buffer[T]) GobEncode() ([]byte, error) { return buf.MarshalBinary() }
+
+// GobDecode decodes the Buffer from the serial format.
+func (buf *Buffer[T]) GobDecode(data []byte) error { return buf.UnmarshalBinary(data) }
@@ -0,0 +1,339 @@
+package ringbuf
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"github.com/djordje200179/extendedlibrary/misc/functions/predication"
+	"slices"
+)
+
+const minCapacity = 8
+
+// Buffer is a cols.Collection that stores elements
+// in a growable circular buffer.
+//
+// Adding and removing elements at both ends
+// takes amortized constant time, as does indexed access.
+// Insertions and removals in the middle move
+// the elements on the shorter side of the index.
+//
+// The zero value is ready to use.
+// Do not copy a non-zero Buffer.
+type Buffer[T any] struct {
+	slice []T
+
+	head, size int
+}
+
+// New creates an empty Buffer.
+func New[T any]() *Buffer[T] { return new(Buffer[T]) }
+
+// NewWithCapacity creates an empty Buffer with the specified initial capacity.
+func NewWithCapacity[T any](initialCapacity int) *Buffer[T] {
+	return &Buffer[T]{slice: make([]T, initialCapacity)}
+}
+
+// NewFromIterable creates a new Buffer from the specified iter.Iterable.
+func NewFromIterable[T any](iterable iter.Iterable[T]) *Buffer[T] {
+	var buf *Buffer[T]
+	if finiteIterable, ok := any(iterable).(iter.FiniteIterable[T]); ok {
+		buf = NewWithCapacity[T](finiteIterable.Size())
+	} else {
+		buf = New[T]()
+	}
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		buf.Append(it.Get())
+	}
+
+	return buf
+}
+
+// FromValues creates a new Buffer from a copy of the specified values.
+func FromValues[T any](values ...T) *Buffer[T] {
+	return &Buffer[T]{slice: slices.Clone(values), size: len(values)}
+}
+
+// Size returns the number of elements.
+func (buf *Buffer[T]) Size() int { return buf.size }
+
+// Capacity returns the number of elements that
+// can be stored without reallocating the memory.
+func (buf *Buffer[T]) Capacity() int { return len(buf.slice) }
+
+func (buf *Buffer[T]) getRealIndex(index int) int {
+	if index >= buf.size || index < -buf.size {
+		panic(cols.IndexOutOfBoundsError{Index: index, Length: buf.size})
+	}
+
+	if index < 0 {
+		index += buf.size
+	}
+
+	return index
+}
+
+func (buf *Buffer[T]) at(index int) *T {
+	return &buf.slice[(buf.head+index)%len(buf.slice)]
+}
+
+func (buf *Buffer[T]) resize(capacity int) {
+	resized := make([]T, capacity)
+	buf.copyTo(resized)
+
+	buf.slice = resized
+	buf.head = 0
+}
+
+func (buf *Buffer[T]) copyTo(dst []T) {
+	if buf.size == 0 {
+		return
+	}
+
+	n := copy(dst, buf.slice[buf.head:min(buf.head+buf.size, len(buf.slice))])
+	copy(dst[n:], buf.slice[:buf.size-n])
+}
+
+// Reserve reserves additional capacity.
+//
+// If additionalCapacity is negative, the function does nothing.
+func (buf *Buffer[T]) Reserve(additionalCapacity int) {
+	if needed := buf.size + additionalCapacity; needed > len(buf.slice) {
+		buf.resize(needed)
+	}
+}
+
+// Shrink shrinks the capacity to match the number of elements.
+func (buf *Buffer[T]) Shrink() { buf.resize(buf.size) }
+
+func (buf *Buffer[T]) grow() {
+	if buf.size < len(buf.slice) {
+		return
+	}
+
+	buf.resize(max(2*len(buf.slice), minCapacity))
+}
+
+// GetRef returns a reference to the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (buf *Buffer[T]) GetRef(index int) *T { return buf.at(buf.getRealIndex(index)) }
+
+// Get returns the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (buf *Buffer[T]) Get(index int) T { return *buf.GetRef(index) }
+
+// Set sets the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (buf *Buffer[T]) Set(index int, value T) { *buf.GetRef(index) = value }
+
+// Prepend inserts the specified element at the beginning.
+func (buf *Buffer[T]) Prepend(value T) {
+	buf.grow()
+
+	buf.head = (buf.head - 1 + len(buf.slice)) % len(buf.slice)
+	buf.slice[buf.head] = value
+	buf.size++
+}
+
+// Append appends the specified element to the end.
+func (buf *Buffer[T]) Append(value T) {
+	buf.grow()
+
+	buf.size++
+	*buf.at(buf.size - 1) = value
+}
+
+// Insert inserts the specified element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (buf *Buffer[T]) Insert(index int, value T) {
+	index = buf.getRealIndex(index)
+	buf.grow()
+
+	if index < buf.size/2 {
+		buf.head = (buf.head - 1 + len(buf.slice)) % len(buf.slice)
+		buf.size++
+
+		for i := range index {
+			*buf.at(i) = *buf.at(i + 1)
+		}
+	} else {
+		buf.size++
+
+		for i := buf.size - 1; i > index; i-- {
+			*buf.at(i) = *buf.at(i - 1)
+		}
+	}
+
+	*buf.at(index) = value
+}
+
+// Remove removes the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (buf *Buffer[T]) Remove(index int) {
+	index = buf.getRealIndex(index)
+
+	var zero T
+	if index < buf.size/2 {
+		for i := index; i > 0; i-- {
+			*buf.at(i) = *buf.at(i - 1)
+		}
+
+		*buf.at(0) = zero
+		buf.head = (buf.head + 1) % len(buf.slice)
+	} else {
+		for i := index; i < buf.size-1; i++ {
+			*buf.at(i) = *buf.at(i + 1)
+		}
+
+		*buf.at(buf.size - 1) = zero
+	}
+
+	buf.size--
+}
+
+// Clear removes all elements.
+func (buf *Buffer[T]) Clear() {
+	clear(buf.slice)
+	buf.head = 0
+	buf.size = 0
+}
+
+// Reverse reverses the order of the elements.
+func (buf *Buffer[T]) Reverse() {
+	for i, j := 0, buf.size-1; i < j; i, j = i+1, j-1 {
+		first, second := buf.at(i), buf.at(j)
+		*first, *second = *second, *first
+	}
+}
+
+// Sort sorts the elements by the specified comparator.
+//
+// The sorting algorithm is a stable variant of quicksort.
+func (buf *Buffer[T]) Sort(comparator comparison.Comparator[T]) {
+	if buf.head+buf.size > len(buf.slice) {
+		buf.resize(len(buf.slice))
+	}
+
+	slices.SortStableFunc(buf.slice[buf.head:buf.head+buf.size], comparator)
+}
+
+// Join moves all elements from the other cols.Collection
+// to the end. The other cols.Collection becomes empty.
+//
+// Joining the Buffer with itself does nothing.
+func (buf *Buffer[T]) Join(other cols.Collection[T]) {
+	if other == cols.Collection[T](buf) {
+		return
+	}
+
+	buf.Reserve(other.Size())
+	for it := other.Iterator(); it.Valid(); it.Move() {
+		buf.Append(it.Get())
+	}
+
+	other.Clear()
+}
+
+// Clone returns a copy of the Buffer.
+func (buf *Buffer[T]) Clone() cols.Collection[T] {
+	return &Buffer[T]{slice: buf.Slice(), size: buf.size}
+}
+
+// Iterator returns a read-only iter.Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (buf *Buffer[T]) Iterator() iter.Iterator[T] { return buf.CollectionIterator() }
+
+// CollectionIterator returns an Iterator over the elements.
+// It can be used to modify the elements while iterating.
+//
+// Iteration starts from the first element.
+func (buf *Buffer[T]) CollectionIterator() cols.Iterator[T] { return &Iterator[T]{buf, 0} }
+
+// Stream streams all elements.
+//
+// It is not safe to modify the Buffer while streaming.
+func (buf *Buffer[T]) Stream(yield func(T) bool) {
+	for i := range buf.size {
+		if !yield(*buf.at(i)) {
+			break
+		}
+	}
+}
+
+// Stream2 streams all elements with their indices.
+//
+// It is not safe to modify the Buffer while streaming.
+func (buf *Buffer[T]) Stream2(yield func(int, T) bool) {
+	for i := range buf.size {
+		if !yield(i, *buf.at(i)) {
+			break
+		}
+	}
+}
+
+// ReverseStream streams all elements in reverse order.
+//
+// It is not safe to modify the Buffer while streaming.
+func (buf *Buffer[T]) ReverseStream(yield func(T) bool) {
+	for i := buf.size - 1; i >= 0; i-- {
+		if !yield(*buf.at(i)) {
+			break
+		}
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+//
+// It is not safe to modify the Buffer while streaming.
+func (buf *Buffer[T]) ReverseStream2(yield func(int, T) bool) {
+	for i := buf.size - 1; i >= 0; i-- {
+		if !yield(i, *buf.at(i)) {
+			break
+		}
+	}
+}
+
+// FindIndex returns the index of the first element
+// that satisfies the specified predicate.
+// If no such element is found, 0 and false are returned.
+func (buf *Buffer[T]) FindIndex(predicate predication.Predicate[T]) (int, bool) {
+	for i := range buf.size {
+		if predicate(*buf.at(i)) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// FindRef returns a reference to the first element
+// that matches the specified predicate.
+// If no element matches the predicate, nil and false are returned.
+func (buf *Buffer[T]) FindRef(predicate predication.Predicate[T]) (*T, bool) {
+	index, ok := buf.FindIndex(predicate)
+	if !ok {
+		return nil, false
+	}
+
+	return buf.at(index), true
+}
+
+// Slice returns a new slice of all elements in order.
+func (buf *Buffer[T]) Slice() []T {
+	slice := make([]T, buf.size)
+	buf.copyTo(slice)
+
+	return slice
+}
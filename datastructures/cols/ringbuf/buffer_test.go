@@ -0,0 +1,101 @@
+package ringbuf
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/array"
+	"slices"
+	"testing"
+)
+
+func TestWrapAround(t *testing.T) {
+	buf := NewWithCapacity[int](4)
+	buf.Append(2)
+	buf.Append(3)
+	buf.Prepend(1)
+	buf.Prepend(0)
+
+	if buf.Capacity() != 4 || buf.head == 0 {
+		t.Fatalf("expected wrapped buffer, got head %d and capacity %d", buf.head, buf.Capacity())
+	}
+
+	buf.Insert(1, 10)
+	buf.Remove(3)
+
+	expected := []int{0, 10, 1, 3}
+	if !slices.Equal(buf.Slice(), expected) {
+		t.Errorf("Slice() = %v, expected %v", buf.Slice(), expected)
+	}
+
+	for i, value := range expected {
+		if buf.Get(i) != value {
+			t.Errorf("Get(%d) = %d, expected %d", i, buf.Get(i), value)
+		}
+	}
+}
+
+func TestShrinkToZero(t *testing.T) {
+	buf := FromValues(1, 2, 3)
+	buf.Clear()
+	buf.Shrink()
+
+	if buf.Capacity() != 0 {
+		t.Fatalf("Capacity() = %d after shrinking an empty buffer", buf.Capacity())
+	}
+
+	buf.Prepend(2)
+	buf.Append(3)
+	buf.Prepend(1)
+
+	if !slices.Equal(buf.Slice(), []int{1, 2, 3}) {
+		t.Errorf("Slice() = %v", buf.Slice())
+	}
+}
+
+func TestFromValuesCopies(t *testing.T) {
+	values := []int{1, 2, 3}
+	buf := FromValues(values...)
+	buf.Set(0, 4)
+	buf.Prepend(5)
+
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("writes to the buffer changed the values to %v", values)
+	}
+}
+
+func TestSortWrapped(t *testing.T) {
+	buf := NewWithCapacity[int](8)
+	for _, value := range []int{5, 3, 8} {
+		buf.Prepend(value)
+	}
+	for _, value := range []int{1, 7, 2} {
+		buf.Append(value)
+	}
+
+	if buf.head+buf.size <= buf.Capacity() {
+		t.Fatal("expected wrapped buffer")
+	}
+
+	buf.Sort(cmp.Compare[int])
+
+	if !slices.Equal(buf.Slice(), []int{1, 2, 3, 5, 7, 8}) {
+		t.Errorf("Slice() = %v", buf.Slice())
+	}
+}
+
+func TestJoin(t *testing.T) {
+	buf := FromValues(1, 2)
+	other := FromValues(3, 4)
+	list := array.FromValues(5)
+
+	buf.Join(other)
+	buf.Join(list)
+	buf.Join(buf)
+
+	if !slices.Equal(buf.Slice(), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Slice() = %v", buf.Slice())
+	}
+
+	if other.Size() != 0 || list.Size() != 0 {
+		t.Error("joined collections were not cleared")
+	}
+}
@@ -0,0 +1,78 @@
+package ringbuf
+
+import "github.com/djordje200179/extendedlibrary/datastructures/iter"
+
+// Iterator is an iterator over a Buffer.
+type Iterator[T any] struct {
+	buf   *Buffer[T]
+	index int
+}
+
+// Valid returns if the iterator is
+// currently pointing to a valid element.
+func (it *Iterator[T]) Valid() bool { return it.index >= 0 && it.index < it.buf.Size() }
+
+// Move moves to the next element.
+func (it *Iterator[T]) Move() {
+	if it.Valid() {
+		it.index++
+	}
+}
+
+// MoveBack moves to the previous element.
+func (it *Iterator[T]) MoveBack() {
+	if it.Valid() {
+		it.index--
+	}
+}
+
+// SeekFirst moves to the first element.
+func (it *Iterator[T]) SeekFirst() { it.index = 0 }
+
+// SeekLast moves to the last element.
+func (it *Iterator[T]) SeekLast() { it.index = it.buf.Size() - 1 }
+
+// GetRef returns a reference to the current element.
+func (it *Iterator[T]) GetRef() *T { return it.buf.GetRef(it.index) }
+
+// Get returns the current element.
+func (it *Iterator[T]) Get() T { return it.buf.Get(it.index) }
+
+// Set sets the current element.
+func (it *Iterator[T]) Set(value T) { it.buf.Set(it.index, value) }
+
+// InsertBefore inserts the specified element
+// before the current element.
+//
+// Iterator then points to the inserted element.
+func (it *Iterator[T]) InsertBefore(value T) { it.buf.Insert(it.index, value) }
+
+// InsertAfter inserts the specified element
+// after the current element.
+//
+// Iterator keeps pointing to the current element.
+func (it *Iterator[T]) InsertAfter(value T) { it.buf.Insert(it.index+1, value) }
+
+// Remove removes the current element.
+//
+// Iterator then points to the next element.
+func (it *Iterator[T]) Remove() { it.buf.Remove(it.index) }
+
+// Seek moves to the element at the specified index.
+func (it *Iterator[T]) Seek(index int) { it.index = index }
+
+// Index returns the current index,
+// or the size of the Buffer if the iterator is not valid.
+func (it *Iterator[T]) Index() int {
+	if !it.Valid() {
+		return it.buf.Size()
+	}
+
+	return it.index
+}
+
+// Distance returns the number of moves needed
+// to get from the current element to the element of the other iterator.
+func (it *Iterator[T]) Distance(other iter.RandomAccessIterator[T]) int {
+	return other.Index() - it.Index()
+}
@@ -0,0 +1,25 @@
+package ringbuf
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	buf := NewWithCapacity[int](6)
+	for i := range 6 {
+		buf.Append(i)
+	}
+	buf.Remove(0)
+	buf.Remove(0)
+	buf.Append(6)
+	buf.Prepend(1)
+
+	values := []int{1, 2, 3, 4, 5, 6}
+
+	itertest.CheckBidirectional[int](t, buf.CollectionIterator().(*Iterator[int]), values)
+	itertest.CheckBidirectional[int](t, New[int]().CollectionIterator().(*Iterator[int]), nil)
+
+	itertest.CheckReverseStream(t, buf.ReverseStream, values)
+	itertest.CheckReverseStream2(t, buf.ReverseStream2, []int{0, 1, 2, 3, 4, 5}, values)
+}
@@ -0,0 +1,23 @@
+package ringbuf
+
+import "encoding/json"
+
+// MarshalJSON encodes the Buffer as a JSON array.
+func (buf *Buffer[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(buf.Slice())
+}
+
+// UnmarshalJSON replaces the elements of the Buffer
+// with the elements of the JSON array.
+func (buf *Buffer[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	buf.slice = slice
+	buf.head = 0
+	buf.size = len(slice)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package rope
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "rope.Rope"
+
+// EncodeBinary writes the Rope to the writer
+// in the serial format, element by element.
+func (rope *Rope[T]) EncodeBinary(w io.Writer) error {
+	return serial.EncodeStream(w, binaryKind, rope.Size(), rope.Stream)
+}
+
+// DecodeBinary replaces the elements of the Rope
+// with the ones read from the reader in the serial format.
+func (rope *Rope[T]) DecodeBinary(r io.Reader) error {
+	var slice []T
+	err := serial.DecodeStream(r, binaryKind, func(value T) {
+		slice = append(slice, value)
+	})
+	if err != nil {
+		return err
+	}
+
+	rope.root = buildBalanced(slice)
+	rope.version++
+
+	return nil
+}
+
+// MarshalBinary encodes the Rope in the serial format.
+func (rope *Rope[T]) MarshalBinary() ([]byte, error) {
+	var encoded bytes.Buffer
+	err := rope.EncodeBinary(&encoded)
+	return encoded.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Rope from the serial format.
+func (rope *Rope[T]) UnmarshalBinary(data []byte) error {
+	return rope.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Rope in the serial format.
+func (rope *Rope[T]) GobEncode() ([]byte, error) { return rope.MarshalBinary() }
+
+// GobDecode decodes the Rope from the serial format.
+func (rope *Rope[T]) GobDecode(data []byte) error { return rope.UnmarshalBinary(data) }
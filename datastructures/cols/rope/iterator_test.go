@@ -0,0 +1,44 @@
+package rope
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"slices"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	values := itertest.Squares(3 * maxLeafSize)
+
+	uneven := New[int]()
+	for _, bounds := range [][2]int{{0, 1}, {1, maxLeafSize}, {maxLeafSize, maxLeafSize + 200}, {maxLeafSize + 200, len(values)}} {
+		uneven.Join(FromSlice(slices.Clone(values[bounds[0]:bounds[1]])))
+	}
+
+	split := FromSlice(itertest.Squares(maxLeafSize))
+	split.Insert(maxLeafSize/2, -1)
+
+	tests := []struct {
+		name     string
+		rope     *Rope[int]
+		expected []int
+	}{
+		{"empty", New[int](), nil},
+		{"single element", FromSlice(itertest.Squares(1)), itertest.Squares(1)},
+		{"full chunk", FromSlice(itertest.Squares(maxLeafSize)), itertest.Squares(maxLeafSize)},
+		{"full chunk and one", FromSlice(itertest.Squares(maxLeafSize + 1)), itertest.Squares(maxLeafSize + 1)},
+		{"many chunks", FromSlice(itertest.Squares(4*maxLeafSize + 3)), itertest.Squares(4*maxLeafSize + 3)},
+		{"uneven chunks", uneven, values},
+		{"split chunk", split, slices.Insert(itertest.Squares(maxLeafSize), maxLeafSize/2, -1)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newIterator := func() iter.BidirectionalIterator[int] {
+				return test.rope.CollectionIterator().(*Iterator[int])
+			}
+
+			itertest.CheckSequence(t, test.rope, newIterator, test.expected)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package rope
+
+import "encoding/json"
+
+// MarshalJSON encodes the Rope as a JSON array.
+func (rope *Rope[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(rope.Slice())
+}
+
+// UnmarshalJSON replaces the elements of the Rope
+// with the elements of the JSON array.
+func (rope *Rope[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	rope.root = buildBalanced(slice)
+	rope.version++
+
+	return nil
+}
@@ -0,0 +1,280 @@
+package rope
+
+import "slices"
+
+const maxLeafSize = 256
+
+// node is either a leaf that holds a chunk of elements,
+// or an internal node that always has both children.
+//
+// Chunks of different leaves can share a backing array,
+// but only the last of them may grow into its unused capacity.
+type node[T any] struct {
+	left, right *node[T]
+
+	chunk []T
+
+	size, height int
+}
+
+func newLeaf[T any](chunk []T) *node[T] {
+	return &node[T]{chunk: chunk, size: len(chunk)}
+}
+
+func newInternal[T any](left, right *node[T]) *node[T] {
+	n := &node[T]{left: left, right: right}
+	n.update()
+
+	return n
+}
+
+func buildBalanced[T any](slice []T) *node[T] {
+	if len(slice) == 0 {
+		return nil
+	}
+
+	if len(slice) <= maxLeafSize {
+		return newLeaf(slice[:len(slice):len(slice)])
+	}
+
+	leaves := (len(slice) + maxLeafSize - 1) / maxLeafSize
+	middle := leaves / 2 * maxLeafSize
+
+	return newInternal(buildBalanced(slice[:middle]), buildBalanced(slice[middle:]))
+}
+
+func (n *node[T]) leaf() bool {
+	return n.left == nil
+}
+
+func nodeSize[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+
+	return n.size
+}
+
+func (n *node[T]) update() {
+	n.size = n.left.size + n.right.size
+	n.height = max(n.left.height, n.right.height) + 1
+}
+
+func (n *node[T]) balanceFactor() int {
+	return n.left.height - n.right.height
+}
+
+func (n *node[T]) rotateLeft() *node[T] {
+	pivot := n.right
+	n.right = pivot.left
+	n.update()
+
+	pivot.left = n
+	pivot.update()
+
+	return pivot
+}
+
+func (n *node[T]) rotateRight() *node[T] {
+	pivot := n.left
+	n.left = pivot.right
+	n.update()
+
+	pivot.right = n
+	pivot.update()
+
+	return pivot
+}
+
+// rebalance restores the AVL property of an internal node
+// whose children are balanced, but may differ in height by two.
+// Small neighbouring leaves of a node that was already
+// their parent are merged into one, so the height
+// of the node never decreases by more than one.
+func (n *node[T]) rebalance() *node[T] {
+	if n.height == 1 && n.left.leaf() && n.right.leaf() && n.left.size+n.right.size <= maxLeafSize/2 {
+		return newLeaf(append(n.left.chunk[:n.left.size:n.left.size], n.right.chunk...))
+	}
+
+	n.update()
+
+	switch balance := n.balanceFactor(); {
+	case balance > 1:
+		if n.left.balanceFactor() < 0 {
+			n.left = n.left.rotateLeft()
+		}
+
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.balanceFactor() > 0 {
+			n.right = n.right.rotateRight()
+		}
+
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+func (n *node[T]) find(index int) (*node[T], int) {
+	curr := n
+	for !curr.leaf() {
+		if index < curr.left.size {
+			curr = curr.left
+		} else {
+			index -= curr.left.size
+			curr = curr.right
+		}
+	}
+
+	return curr, index
+}
+
+func insert[T any](n *node[T], index int, value T) *node[T] {
+	if n == nil {
+		return newLeaf([]T{value})
+	}
+
+	if n.leaf() {
+		n.chunk = slices.Insert(n.chunk, index, value)
+		n.size++
+
+		if n.size > maxLeafSize {
+			half := n.size / 2
+			return newInternal(newLeaf(n.chunk[:half:half]), newLeaf(n.chunk[half:]))
+		}
+
+		return n
+	}
+
+	if index <= n.left.size {
+		n.left = insert(n.left, index, value)
+	} else {
+		n.right = insert(n.right, index-n.left.size, value)
+	}
+
+	return n.rebalance()
+}
+
+func remove[T any](n *node[T], index int) *node[T] {
+	if n.leaf() {
+		if n.size == 1 {
+			return nil
+		}
+
+		n.chunk = slices.Delete(n.chunk, index, index+1)
+		n.size--
+
+		return n
+	}
+
+	if index < n.left.size {
+		n.left = remove(n.left, index)
+		if n.left == nil {
+			return n.right
+		}
+	} else {
+		n.right = remove(n.right, index-n.left.size)
+		if n.right == nil {
+			return n.left
+		}
+	}
+
+	return n.rebalance()
+}
+
+// join concatenates two balanced trees
+// in time proportional to the difference of their heights.
+func join[T any](left, right *node[T]) *node[T] {
+	if left == nil {
+		return right
+	}
+
+	if right == nil {
+		return left
+	}
+
+	switch {
+	case left.height > right.height+1:
+		left.right = join(left.right, right)
+		return left.rebalance()
+	case right.height > left.height+1:
+		right.left = join(left, right.left)
+		return right.rebalance()
+	case left.leaf() && right.leaf() && left.size+right.size <= maxLeafSize:
+		return newLeaf(append(left.chunk[:left.size:left.size], right.chunk...))
+	default:
+		return newInternal(left, right)
+	}
+}
+
+// split divides the tree into the first index elements and the rest.
+func split[T any](n *node[T], index int) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+
+	if index == 0 {
+		return nil, n
+	}
+
+	if index == n.size {
+		return n, nil
+	}
+
+	if n.leaf() {
+		return newLeaf(n.chunk[:index:index]), newLeaf(n.chunk[index:])
+	}
+
+	if index <= n.left.size {
+		first, second := split(n.left, index)
+		return first, join(second, n.right)
+	}
+
+	first, second := split(n.right, index-n.left.size)
+	return join(n.left, first), second
+}
+
+func (n *node[T]) stream(yield func(T) bool) bool {
+	if n.leaf() {
+		for _, value := range n.chunk {
+			if !yield(value) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return n.left.stream(yield) && n.right.stream(yield)
+}
+
+func (n *node[T]) reverseStream(yield func(T) bool) bool {
+	if n.leaf() {
+		for i := len(n.chunk) - 1; i >= 0; i-- {
+			if !yield(n.chunk[i]) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return n.right.reverseStream(yield) && n.left.reverseStream(yield)
+}
+
+func (n *node[T]) appendRange(dst []T, from, to int) []T {
+	if n.leaf() {
+		return append(dst, n.chunk[from:to]...)
+	}
+
+	if from < n.left.size {
+		dst = n.left.appendRange(dst, from, min(to, n.left.size))
+	}
+
+	if to > n.left.size {
+		dst = n.right.appendRange(dst, max(from-n.left.size, 0), to-n.left.size)
+	}
+
+	return dst
+}
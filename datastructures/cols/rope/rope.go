@@ -0,0 +1,346 @@
+package rope
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"github.com/djordje200179/extendedlibrary/misc/functions/predication"
+	"slices"
+)
+
+// Rope is a cols.Collection that stores elements
+// in chunks at the leaves of a balanced binary tree.
+//
+// Indexed access, insertion and removal take O(log n) time,
+// no matter where in the Rope they happen. Splitting a Rope
+// and joining two of them also take O(log n) time,
+// which makes it suitable for large editable sequences like text.
+//
+// The zero value is ready to use.
+// Do not copy a non-zero Rope.
+type Rope[T any] struct {
+	root *node[T]
+
+	version int
+}
+
+// New creates an empty Rope.
+func New[T any]() *Rope[T] { return new(Rope[T]) }
+
+// NewFromIterable creates a new Rope from the specified iter.Iterable.
+func NewFromIterable[T any](iterable iter.Iterable[T]) *Rope[T] {
+	var values []T
+	if finiteIterable, ok := any(iterable).(iter.FiniteIterable[T]); ok {
+		values = make([]T, 0, finiteIterable.Size())
+	}
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		values = append(values, it.Get())
+	}
+
+	return FromSlice(values)
+}
+
+// FromSlice creates a new Rope from the specified slice.
+// The Rope uses the slice as its storage,
+// so it shouldn't be modified afterward.
+func FromSlice[T any](slice []T) *Rope[T] { return &Rope[T]{root: buildBalanced(slice)} }
+
+// FromValues creates a new Rope from the specified values.
+func FromValues[T any](values ...T) *Rope[T] { return FromSlice(values) }
+
+// Size returns the number of elements.
+func (rope *Rope[T]) Size() int { return nodeSize(rope.root) }
+
+func (rope *Rope[T]) getRealIndex(index int) int {
+	size := rope.Size()
+
+	if index >= size || index < -size {
+		panic(cols.IndexOutOfBoundsError{Index: index, Length: size})
+	}
+
+	if index < 0 {
+		index += size
+	}
+
+	return index
+}
+
+func (rope *Rope[T]) getRealRange(from, to int) (int, int) {
+	size := rope.Size()
+
+	if from < 0 {
+		from += size
+	}
+
+	if to < 0 {
+		to += size
+	}
+
+	if from < 0 || from > size {
+		panic(cols.IndexOutOfBoundsError{Index: from, Length: size})
+	}
+
+	if to < from || to > size {
+		panic(cols.IndexOutOfBoundsError{Index: to, Length: size})
+	}
+
+	return from, to
+}
+
+// GetRef returns a reference to the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) GetRef(index int) *T {
+	leaf, offset := rope.root.find(rope.getRealIndex(index))
+	return &leaf.chunk[offset]
+}
+
+// Get returns the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) Get(index int) T { return *rope.GetRef(index) }
+
+// Set sets the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) Set(index int, value T) { *rope.GetRef(index) = value }
+
+func (rope *Rope[T]) insert(index int, value T) {
+	rope.root = insert(rope.root, index, value)
+	rope.version++
+}
+
+// Prepend inserts the specified element at the beginning.
+func (rope *Rope[T]) Prepend(value T) { rope.insert(0, value) }
+
+// PrependMany inserts the specified elements at the beginning.
+func (rope *Rope[T]) PrependMany(values ...T) { rope.insertMany(0, values) }
+
+// Append appends the specified element to the end.
+func (rope *Rope[T]) Append(value T) { rope.insert(rope.Size(), value) }
+
+// AppendMany appends the specified elements to the end.
+func (rope *Rope[T]) AppendMany(values ...T) { rope.insertMany(rope.Size(), values) }
+
+// Insert inserts the specified element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) Insert(index int, value T) { rope.insert(rope.getRealIndex(index), value) }
+
+func (rope *Rope[T]) insertMany(index int, values []T) {
+	first, second := split(rope.root, index)
+	inserted := buildBalanced(slices.Clone(values))
+
+	rope.root = join(join(first, inserted), second)
+	rope.version++
+}
+
+// InsertMany inserts the specified elements at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) InsertMany(index int, values ...T) {
+	rope.insertMany(rope.getRealIndex(index), values)
+}
+
+// Remove removes the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) Remove(index int) {
+	rope.root = remove(rope.root, rope.getRealIndex(index))
+	rope.version++
+}
+
+// RemoveRange removes the elements in the specified range [from, to).
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the range is out of bounds.
+func (rope *Rope[T]) RemoveRange(from, to int) {
+	from, to = rope.getRealRange(from, to)
+
+	first, rest := split(rope.root, from)
+	_, second := split(rest, to-from)
+
+	rope.root = join(first, second)
+	rope.version++
+}
+
+// Clear removes all elements.
+func (rope *Rope[T]) Clear() {
+	rope.root = nil
+	rope.version++
+}
+
+func (rope *Rope[T]) rebuild(modify func(slice []T)) {
+	slice := rope.Slice()
+	modify(slice)
+
+	rope.root = buildBalanced(slice)
+	rope.version++
+}
+
+// Reverse reverses the order of the elements.
+func (rope *Rope[T]) Reverse() { rope.rebuild(slices.Reverse[[]T]) }
+
+// Sort sorts the elements by the specified comparator.
+//
+// The sorting algorithm is a stable variant of quicksort.
+func (rope *Rope[T]) Sort(comparator comparison.Comparator[T]) {
+	rope.rebuild(func(slice []T) {
+		slices.SortStableFunc(slice, comparator)
+	})
+}
+
+// Join moves all elements from the other cols.Collection
+// to the end. The other cols.Collection becomes empty.
+//
+// If the other collection is a Rope, the Ropes are
+// concatenated in O(log n) time without moving the elements.
+// Otherwise, the elements are moved one by one.
+func (rope *Rope[T]) Join(other cols.Collection[T]) {
+	switch second := other.(type) {
+	case *Rope[T]:
+		if second == rope {
+			return
+		}
+
+		rope.root = join(rope.root, second.root)
+		rope.version++
+	default:
+		values := make([]T, 0, other.Size())
+		for it := other.Iterator(); it.Valid(); it.Move() {
+			values = append(values, it.Get())
+		}
+
+		rope.root = join(rope.root, buildBalanced(values))
+		rope.version++
+	}
+
+	other.Clear()
+}
+
+// Split moves the elements before the specified index
+// to the first returned Rope and the rest to the second one.
+// The Rope becomes empty.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (rope *Rope[T]) Split(index int) (*Rope[T], *Rope[T]) {
+	index, _ = rope.getRealRange(index, index)
+
+	first, second := split(rope.root, index)
+	rope.Clear()
+
+	return &Rope[T]{root: first}, &Rope[T]{root: second}
+}
+
+// Clone returns a copy of the Rope.
+func (rope *Rope[T]) Clone() cols.Collection[T] { return FromSlice(rope.Slice()) }
+
+// Iterator returns a read-only iter.Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (rope *Rope[T]) Iterator() iter.Iterator[T] { return rope.CollectionIterator() }
+
+// CollectionIterator returns an Iterator over the elements.
+// It can be used to modify the elements while iterating.
+//
+// Iteration starts from the first element.
+func (rope *Rope[T]) CollectionIterator() cols.Iterator[T] { return &Iterator[T]{rope: rope} }
+
+// Stream streams all elements.
+//
+// It is not safe to modify the Rope while streaming.
+func (rope *Rope[T]) Stream(yield func(T) bool) {
+	if rope.root != nil {
+		rope.root.stream(yield)
+	}
+}
+
+// Stream2 streams all elements with their indices.
+//
+// It is not safe to modify the Rope while streaming.
+func (rope *Rope[T]) Stream2(yield func(int, T) bool) {
+	i := 0
+	for value := range rope.Stream {
+		if !yield(i, value) {
+			break
+		}
+
+		i++
+	}
+}
+
+// ReverseStream streams all elements in reverse order.
+//
+// It is not safe to modify the Rope while streaming.
+func (rope *Rope[T]) ReverseStream(yield func(T) bool) {
+	if rope.root != nil {
+		rope.root.reverseStream(yield)
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+//
+// It is not safe to modify the Rope while streaming.
+func (rope *Rope[T]) ReverseStream2(yield func(int, T) bool) {
+	i := rope.Size() - 1
+	for value := range rope.ReverseStream {
+		if !yield(i, value) {
+			break
+		}
+
+		i--
+	}
+}
+
+// FindIndex returns the index of the first element
+// that satisfies the specified predicate.
+// If no such element is found, 0 and false are returned.
+func (rope *Rope[T]) FindIndex(predicate predication.Predicate[T]) (int, bool) {
+	for i, value := range rope.Stream2 {
+		if predicate(value) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// FindRef returns a reference to the first element
+// that matches the specified predicate.
+// If no element matches the predicate, nil and false are returned.
+func (rope *Rope[T]) FindRef(predicate predication.Predicate[T]) (*T, bool) {
+	index, ok := rope.FindIndex(predicate)
+	if !ok {
+		return nil, false
+	}
+
+	return rope.GetRef(index), true
+}
+
+// Slice returns a new slice of all elements.
+func (rope *Rope[T]) Slice() []T { return rope.SliceRange(0, rope.Size()) }
+
+// SliceRange returns a new slice of elements
+// in the specified range [from, to).
+// Only the leaves that hold the elements are visited.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the range is out of bounds.
+func (rope *Rope[T]) SliceRange(from, to int) []T {
+	from, to = rope.getRealRange(from, to)
+
+	slice := make([]T, 0, to-from)
+	if from == to {
+		return slice
+	}
+
+	return rope.root.appendRange(slice, from, to)
+}
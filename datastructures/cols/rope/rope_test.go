@@ -0,0 +1,217 @@
+package rope
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func checkBalance[T any](t *testing.T, n *node[T]) {
+	if n == nil || n.leaf() {
+		return
+	}
+
+	if balance := n.balanceFactor(); balance > 1 || balance < -1 {
+		t.Fatalf("node of size %d has balance factor %d", n.size, balance)
+	}
+
+	checkBalance(t, n.left)
+	checkBalance(t, n.right)
+}
+
+func TestRandomEdits(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	rope := New[int]()
+	var expected []int
+	for i := range 20000 {
+		switch index := random.Intn(len(expected) + 1); random.Intn(4) {
+		case 0:
+			rope.Append(i)
+			expected = append(expected, i)
+		case 1:
+			if index < len(expected) {
+				rope.Insert(index, i)
+				expected = slices.Insert(expected, index, i)
+			}
+		case 2:
+			if index < len(expected) {
+				rope.Remove(index)
+				expected = slices.Delete(expected, index, index+1)
+			}
+		case 3:
+			first, second := rope.Split(index)
+			first.Join(second)
+			rope = first
+		}
+	}
+
+	checkBalance(t, rope.root)
+
+	if !slices.Equal(rope.Slice(), expected) {
+		t.Fatal("elements differ from the expected ones")
+	}
+
+	if !slices.Equal(rope.SliceRange(100, 300), expected[100:300]) {
+		t.Error("SliceRange(100, 300) differs from the expected elements")
+	}
+}
+
+func sequence(from, to int) []int {
+	values := make([]int, 0, to-from)
+	for i := from; i < to; i++ {
+		values = append(values, i)
+	}
+
+	return values
+}
+
+func checkElements(t *testing.T, rope *Rope[int], expected []int) {
+	t.Helper()
+
+	checkBalance(t, rope.root)
+
+	if got := rope.Slice(); !slices.Equal(got, expected) {
+		t.Fatalf("elements are %v, expected %v", got, expected)
+	}
+}
+
+func TestSplitDoesNotAlias(t *testing.T) {
+	rope := FromSlice(sequence(0, 1000))
+	rope.Insert(300, -1)
+	expected := slices.Insert(sequence(0, 1000), 300, -1)
+
+	first, second := rope.Split(290)
+	expectedFirst, expectedSecond := slices.Clone(expected[:290]), slices.Clone(expected[290:])
+
+	first.Append(-2)
+	first.Insert(289, -3)
+	expectedFirst = append(expectedFirst, -2)
+	expectedFirst = slices.Insert(expectedFirst, 289, -3)
+
+	second.Prepend(-4)
+	second.Insert(5, -5)
+	second.Remove(20)
+	second.Set(1, -6)
+	expectedSecond = slices.Insert(expectedSecond, 0, -4)
+	expectedSecond = slices.Insert(expectedSecond, 5, -5)
+	expectedSecond = slices.Delete(expectedSecond, 20, 21)
+	expectedSecond[1] = -6
+
+	checkElements(t, first, expectedFirst)
+	checkElements(t, second, expectedSecond)
+
+	first.Join(second)
+	first.Insert(291, -7)
+	checkElements(t, first, slices.Insert(append(expectedFirst, expectedSecond...), 291, -7))
+
+	if rope.Size() != 0 || second.Size() != 0 {
+		t.Errorf("split and joined ropes have sizes %d and %d", rope.Size(), second.Size())
+	}
+}
+
+func TestInsertMany(t *testing.T) {
+	rope := FromSlice(sequence(0, 500))
+	expected := sequence(0, 500)
+
+	values := sequence(1000, 1600)
+	rope.InsertMany(250, values...)
+	expected = slices.Insert(expected, 250, values...)
+
+	values[0] = -1
+	checkElements(t, rope, expected)
+
+	rope.InsertMany(-1, 7, 8)
+	rope.PrependMany(1, 2)
+	rope.AppendMany(3, 4)
+	expected = slices.Insert(expected, len(expected)-1, 7, 8)
+	expected = append([]int{1, 2}, expected...)
+	expected = append(expected, 3, 4)
+	checkElements(t, rope, expected)
+
+	rope.InsertMany(0)
+	checkElements(t, rope, expected)
+}
+
+func TestRemoveRange(t *testing.T) {
+	tests := []struct {
+		from, to int
+	}{
+		{0, 0},
+		{0, 1000},
+		{100, 700},
+		{255, 257},
+		{-10, -1},
+		{999, 1000},
+	}
+
+	for _, test := range tests {
+		rope := FromSlice(sequence(0, 1000))
+		rope.RemoveRange(test.from, test.to)
+
+		from, to := test.from, test.to
+		if from < 0 {
+			from, to = from+1000, to+1000
+		}
+
+		checkElements(t, rope, slices.Delete(sequence(0, 1000), from, to))
+	}
+
+	for _, test := range []struct{ from, to int }{{5, 4}, {0, 1001}, {-1001, 0}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("RemoveRange(%d, %d) didn't panic", test.from, test.to)
+				}
+			}()
+
+			FromSlice(sequence(0, 1000)).RemoveRange(test.from, test.to)
+		}()
+	}
+}
+
+func TestIteratorAfterModification(t *testing.T) {
+	rope := FromSlice(sequence(0, 1000))
+
+	it := rope.CollectionIterator().(*Iterator[int])
+	it.Seek(300)
+	if it.Get() != 300 {
+		t.Fatalf("Get() = %d", it.Get())
+	}
+
+	rope.Insert(0, -1)
+	if it.Get() != 299 {
+		t.Errorf("Get() after inserting at the front = %d", it.Get())
+	}
+
+	rope.RemoveRange(250, 260)
+	if it.Get() != 309 {
+		t.Errorf("Get() after removing a range = %d", it.Get())
+	}
+
+	it.Set(-2)
+	if rope.Get(300) != -2 {
+		t.Errorf("Set() through iterator changed a wrong element")
+	}
+
+	other := FromSlice(sequence(0, 10))
+	other.Join(rope)
+	rope.Join(other)
+	if it.Get() != 299 || rope.Get(310) != -2 {
+		t.Errorf("Get() after joining = %d", it.Get())
+	}
+
+	for it.SeekFirst(); it.Valid(); {
+		if it.Get()%2 == 0 {
+			it.Remove()
+		} else {
+			it.Move()
+		}
+	}
+
+	for value := range rope.Stream {
+		if value%2 == 0 {
+			t.Fatalf("even value %d was not removed", value)
+		}
+	}
+}
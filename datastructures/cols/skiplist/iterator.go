@@ -0,0 +1,119 @@
+package skiplist
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+)
+
+// Iterator is an iterator over a List.
+//
+// It remembers the current node and its index,
+// so moving to the neighbouring elements takes constant time
+// and modifying the List through it takes O(log n) time.
+type Iterator[T any] struct {
+	list *List[T]
+
+	curr  *node[T]
+	index int
+}
+
+// Valid returns if the iterator is
+// currently pointing to a valid element.
+func (it *Iterator[T]) Valid() bool { return it.curr != nil }
+
+// Move moves to the next element.
+func (it *Iterator[T]) Move() {
+	if it.curr == nil {
+		return
+	}
+
+	it.curr = it.curr.links[0].next
+	it.index++
+}
+
+// MoveBack moves to the previous element.
+func (it *Iterator[T]) MoveBack() {
+	if it.curr == nil {
+		return
+	}
+
+	it.curr = it.curr.prev
+	it.index--
+}
+
+// SeekFirst moves to the first element.
+func (it *Iterator[T]) SeekFirst() { it.Seek(0) }
+
+// SeekLast moves to the last element.
+func (it *Iterator[T]) SeekLast() { it.Seek(it.list.size - 1) }
+
+// GetRef returns a reference to the current element.
+func (it *Iterator[T]) GetRef() *T { return &it.curr.value }
+
+// Get returns the current element.
+func (it *Iterator[T]) Get() T { return it.curr.value }
+
+// Set sets the current element.
+func (it *Iterator[T]) Set(value T) { it.curr.value = value }
+
+func (it *Iterator[T]) checkValid() {
+	if it.curr == nil {
+		panic(cols.IndexOutOfBoundsError{Index: it.index, Length: it.list.size})
+	}
+}
+
+// InsertBefore inserts the specified element
+// before the current element.
+//
+// Iterator then points to the inserted element.
+// Panic occurs if the iterator is not valid.
+func (it *Iterator[T]) InsertBefore(value T) {
+	it.checkValid()
+	it.curr = it.list.insert(it.index, value)
+}
+
+// InsertAfter inserts the specified element
+// after the current element.
+//
+// Iterator keeps pointing to the current element.
+// Panic occurs if the iterator is not valid.
+func (it *Iterator[T]) InsertAfter(value T) {
+	it.checkValid()
+	it.list.insert(it.index+1, value)
+}
+
+// Remove removes the current element.
+//
+// Iterator then points to the next element.
+// Panic occurs if the iterator is not valid.
+func (it *Iterator[T]) Remove() {
+	it.checkValid()
+	it.curr = it.list.remove(it.index)
+}
+
+// Seek moves to the element at the specified index.
+func (it *Iterator[T]) Seek(index int) {
+	it.index = index
+
+	if index < 0 || index >= it.list.size {
+		it.curr = nil
+	} else {
+		it.curr = it.list.getNode(index)
+	}
+}
+
+// Index returns the current index,
+// or the size of the List if the iterator is not valid.
+func (it *Iterator[T]) Index() int {
+	if it.curr == nil {
+		return it.list.size
+	}
+
+	return it.index
+}
+
+// Distance returns the number of moves needed
+// to get from the current element to the element of the other iterator.
+func (it *Iterator[T]) Distance(other iter.RandomAccessIterator[T]) int {
+	return other.Index() - it.Index()
+}
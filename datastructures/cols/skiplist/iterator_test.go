@@ -0,0 +1,55 @@
+package skiplist
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"slices"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	values := itertest.Squares(200)
+
+	duplicates := make([]int, 100)
+	for i := range duplicates {
+		duplicates[i] = i / 10
+	}
+
+	prepended := New[int]()
+	for i := len(values) - 1; i >= 0; i-- {
+		prepended.Prepend(values[i])
+	}
+
+	lowered := FromSlice(values)
+	tallest := lowered.head.links[len(lowered.head.links)-1].width - 1
+	lowered.Remove(tallest)
+
+	emptied := FromSlice(values)
+	for emptied.Size() > 1 {
+		emptied.Remove(0)
+	}
+
+	tests := []struct {
+		name     string
+		list     *List[int]
+		expected []int
+	}{
+		{"empty", New[int](), nil},
+		{"single element", FromSlice(itertest.Squares(1)), itertest.Squares(1)},
+		{"many elements", FromSlice(values), values},
+		{"duplicates", FromSlice(duplicates), duplicates},
+		{"built by prepending", prepended, values},
+		{"tallest node removed", lowered, slices.Delete(slices.Clone(values), tallest, tallest+1)},
+		{"emptied down to one", emptied, values[len(values)-1:]},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			newIterator := func() iter.BidirectionalIterator[int] {
+				return test.list.ListIterator()
+			}
+
+			itertest.CheckSequence(t, test.list, newIterator, test.expected)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package skiplist
+
+import "encoding/json"
+
+// MarshalJSON encodes the List as a JSON array.
+func (list *List[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(list.Slice())
+}
+
+// UnmarshalJSON replaces the elements of the List
+// with the elements of the JSON array.
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	list.build(slice)
+
+	return nil
+}
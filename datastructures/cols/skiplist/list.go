@@ -0,0 +1,394 @@
+package skiplist
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"github.com/djordje200179/extendedlibrary/misc/functions/predication"
+	"slices"
+)
+
+// List is a cols.Collection that stores elements
+// in an indexable skip list.
+//
+// Every link of the skip list knows how many elements
+// it skips over, so Get, Set, Insert and Remove by index
+// take O(log n) expected time, no matter where
+// in the List they happen.
+//
+// The zero value is ready to use.
+// Do not copy a non-zero List.
+type List[T any] struct {
+	head node[T]
+	tail *node[T]
+
+	size int
+}
+
+// New creates an empty List.
+func New[T any]() *List[T] { return new(List[T]) }
+
+// NewFromIterable creates a new List from the specified iter.Iterable.
+func NewFromIterable[T any](iterable iter.Iterable[T]) *List[T] {
+	var values []T
+	if finiteIterable, ok := any(iterable).(iter.FiniteIterable[T]); ok {
+		values = make([]T, 0, finiteIterable.Size())
+	}
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		values = append(values, it.Get())
+	}
+
+	return FromSlice(values)
+}
+
+// FromSlice creates a new List from the specified slice.
+// The elements are copied, so the slice can be modified afterward.
+func FromSlice[T any](slice []T) *List[T] {
+	list := New[T]()
+	list.build(slice)
+
+	return list
+}
+
+// FromValues creates a new List from the specified values.
+func FromValues[T any](values ...T) *List[T] { return FromSlice(values) }
+
+// build replaces the elements with the specified ones in O(n) time.
+func (list *List[T]) build(values []T) {
+	list.head = node[T]{}
+	list.tail = nil
+	list.size = len(values)
+
+	var last [maxLevel]*node[T]
+	var positions [maxLevel]int
+
+	for i, value := range values {
+		height := randomLevel()
+		for level := len(list.head.links); level < height; level++ {
+			list.head.links = append(list.head.links, link[T]{})
+			last[level], positions[level] = &list.head, -1
+		}
+
+		curr := &node[T]{value: value, links: make([]link[T], height), prev: list.tail}
+		for level := range height {
+			last[level].links[level] = link[T]{curr, i - positions[level]}
+			last[level], positions[level] = curr, i
+		}
+
+		list.tail = curr
+	}
+
+	for level := range list.head.links {
+		last[level].links[level] = link[T]{nil, len(values) - positions[level]}
+	}
+}
+
+// Size returns the number of elements.
+func (list *List[T]) Size() int { return list.size }
+
+func (list *List[T]) getRealIndex(index int) int {
+	if index >= list.size || index < -list.size {
+		panic(cols.IndexOutOfBoundsError{Index: index, Length: list.size})
+	}
+
+	if index < 0 {
+		index += list.size
+	}
+
+	return index
+}
+
+func (list *List[T]) getNode(index int) *node[T] {
+	curr, position := &list.head, -1
+	for level := len(list.head.links) - 1; level >= 0; level-- {
+		for l := curr.links[level]; l.next != nil && position+l.width <= index; l = curr.links[level] {
+			position += l.width
+			curr = l.next
+		}
+	}
+
+	return curr
+}
+
+// predecessors finds the last node before the specified index
+// and its position on every level.
+func (list *List[T]) predecessors(index int, update *[maxLevel]*node[T], positions *[maxLevel]int) {
+	curr, position := &list.head, -1
+	for level := len(list.head.links) - 1; level >= 0; level-- {
+		for l := curr.links[level]; l.next != nil && position+l.width < index; l = curr.links[level] {
+			position += l.width
+			curr = l.next
+		}
+
+		update[level], positions[level] = curr, position
+	}
+}
+
+func (list *List[T]) insert(index int, value T) *node[T] {
+	var update [maxLevel]*node[T]
+	var positions [maxLevel]int
+	list.predecessors(index, &update, &positions)
+
+	height := randomLevel()
+	for level := len(list.head.links); level < height; level++ {
+		list.head.links = append(list.head.links, link[T]{width: list.size + 1})
+		update[level], positions[level] = &list.head, -1
+	}
+
+	inserted := &node[T]{value: value, links: make([]link[T], height)}
+	for level := range list.head.links {
+		pred := update[level]
+		if level >= height {
+			pred.links[level].width++
+			continue
+		}
+
+		old := pred.links[level]
+		inserted.links[level] = link[T]{old.next, positions[level] + old.width + 1 - index}
+		pred.links[level] = link[T]{inserted, index - positions[level]}
+	}
+
+	if update[0] != &list.head {
+		inserted.prev = update[0]
+	}
+
+	if next := inserted.links[0].next; next != nil {
+		next.prev = inserted
+	} else {
+		list.tail = inserted
+	}
+
+	list.size++
+
+	return inserted
+}
+
+// remove removes the element at the specified index
+// and returns the node of the next element.
+func (list *List[T]) remove(index int) *node[T] {
+	var update [maxLevel]*node[T]
+	var positions [maxLevel]int
+	list.predecessors(index, &update, &positions)
+
+	removed := update[0].links[0].next
+	for level := range list.head.links {
+		pred := update[level]
+		if level >= len(removed.links) {
+			pred.links[level].width--
+			continue
+		}
+
+		old := removed.links[level]
+		pred.links[level] = link[T]{old.next, pred.links[level].width + old.width - 1}
+	}
+
+	next := removed.links[0].next
+	if next != nil {
+		next.prev = removed.prev
+	} else {
+		list.tail = removed.prev
+	}
+
+	top := len(list.head.links)
+	for top > 0 && list.head.links[top-1].next == nil {
+		top--
+	}
+	list.head.links = list.head.links[:top]
+
+	list.size--
+
+	return next
+}
+
+// GetRef returns a reference to the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (list *List[T]) GetRef(index int) *T { return &list.getNode(list.getRealIndex(index)).value }
+
+// Get returns the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (list *List[T]) Get(index int) T { return *list.GetRef(index) }
+
+// Set sets the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (list *List[T]) Set(index int, value T) { *list.GetRef(index) = value }
+
+// Prepend inserts the specified element at the beginning.
+func (list *List[T]) Prepend(value T) { list.insert(0, value) }
+
+// Append appends the specified element to the end.
+func (list *List[T]) Append(value T) { list.insert(list.size, value) }
+
+// Insert inserts the specified element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (list *List[T]) Insert(index int, value T) { list.insert(list.getRealIndex(index), value) }
+
+// Remove removes the element at the specified index.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (list *List[T]) Remove(index int) { list.remove(list.getRealIndex(index)) }
+
+// Clear removes all elements.
+func (list *List[T]) Clear() {
+	list.head = node[T]{}
+	list.tail = nil
+	list.size = 0
+}
+
+// rewrite modifies the elements in a slice
+// and stores them back without changing the structure.
+func (list *List[T]) rewrite(modify func(slice []T)) {
+	slice := list.Slice()
+	modify(slice)
+
+	curr := list.head.links
+	for _, value := range slice {
+		curr[0].next.value = value
+		curr = curr[0].next.links
+	}
+}
+
+// Reverse reverses the order of the elements.
+func (list *List[T]) Reverse() { list.rewrite(slices.Reverse[[]T]) }
+
+// Sort sorts the elements by the specified comparator.
+//
+// The sorting algorithm is a stable variant of quicksort.
+func (list *List[T]) Sort(comparator comparison.Comparator[T]) {
+	list.rewrite(func(slice []T) {
+		slices.SortStableFunc(slice, comparator)
+	})
+}
+
+// Join moves all elements from the other cols.Collection
+// to the end. The other cols.Collection becomes empty.
+func (list *List[T]) Join(other cols.Collection[T]) {
+	if other == cols.Collection[T](list) {
+		return
+	}
+
+	for it := other.Iterator(); it.Valid(); it.Move() {
+		list.Append(it.Get())
+	}
+
+	other.Clear()
+}
+
+// Clone returns a copy of the List.
+func (list *List[T]) Clone() cols.Collection[T] { return FromSlice(list.Slice()) }
+
+// Iterator returns a read-only iter.Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (list *List[T]) Iterator() iter.Iterator[T] { return list.CollectionIterator() }
+
+// CollectionIterator returns an Iterator over the elements.
+// It can be used to modify the elements while iterating.
+//
+// Iteration starts from the first element.
+func (list *List[T]) CollectionIterator() cols.Iterator[T] { return list.ListIterator() }
+
+// ListIterator returns an Iterator over the elements.
+//
+// Iteration starts from the first element.
+func (list *List[T]) ListIterator() *Iterator[T] {
+	it := &Iterator[T]{list: list}
+	it.SeekFirst()
+
+	return it
+}
+
+// Stream streams all elements.
+//
+// It is not safe to modify the List while streaming.
+func (list *List[T]) Stream(yield func(T) bool) {
+	for curr := list.head.links; len(curr) > 0 && curr[0].next != nil; curr = curr[0].next.links {
+		if !yield(curr[0].next.value) {
+			return
+		}
+	}
+}
+
+// Stream2 streams all elements with their indices.
+//
+// It is not safe to modify the List while streaming.
+func (list *List[T]) Stream2(yield func(int, T) bool) {
+	i := 0
+	for value := range list.Stream {
+		if !yield(i, value) {
+			break
+		}
+
+		i++
+	}
+}
+
+// ReverseStream streams all elements in reverse order.
+//
+// It is not safe to modify the List while streaming.
+func (list *List[T]) ReverseStream(yield func(T) bool) {
+	for curr := list.tail; curr != nil; curr = curr.prev {
+		if !yield(curr.value) {
+			return
+		}
+	}
+}
+
+// ReverseStream2 streams all elements with their indices in reverse order.
+//
+// It is not safe to modify the List while streaming.
+func (list *List[T]) ReverseStream2(yield func(int, T) bool) {
+	i := list.size - 1
+	for value := range list.ReverseStream {
+		if !yield(i, value) {
+			break
+		}
+
+		i--
+	}
+}
+
+// FindIndex returns the index of the first element
+// that satisfies the specified predicate.
+// If no such element is found, 0 and false are returned.
+func (list *List[T]) FindIndex(predicate predication.Predicate[T]) (int, bool) {
+	for i, value := range list.Stream2 {
+		if predicate(value) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+// FindRef returns a reference to the first element
+// that matches the specified predicate.
+// If no element matches the predicate, nil and false are returned.
+func (list *List[T]) FindRef(predicate predication.Predicate[T]) (*T, bool) {
+	for it := list.ListIterator(); it.Valid(); it.Move() {
+		if ref := it.GetRef(); predicate(*ref) {
+			return ref, true
+		}
+	}
+
+	return nil, false
+}
+
+// Slice returns a new slice of all elements.
+func (list *List[T]) Slice() []T {
+	slice := make([]T, 0, list.size)
+	for value := range list.Stream {
+		slice = append(slice, value)
+	}
+
+	return slice
+}
@@ -0,0 +1,189 @@
+package skiplist
+
+import (
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func checkWidths[T any](t *testing.T, list *List[T]) {
+	t.Helper()
+
+	for level := range list.head.links {
+		curr, position := &list.head, -1
+		for {
+			l := curr.links[level]
+			if l.next == nil {
+				if position+l.width != list.size {
+					t.Fatalf("last link on level %d ends at %d instead of %d", level, position+l.width, list.size)
+				}
+
+				break
+			}
+
+			position += l.width
+			curr = l.next
+
+			if found := list.getNode(position); found != curr {
+				t.Fatalf("link on level %d points to a wrong position %d", level, position)
+			}
+		}
+	}
+}
+
+func checkElements(t *testing.T, list *List[int], expected []int) {
+	t.Helper()
+
+	checkWidths(t, list)
+
+	if got := list.Slice(); !slices.Equal(got, expected) {
+		t.Fatalf("elements are %v, expected %v", got, expected)
+	}
+
+	var reversed []int
+	for value := range list.ReverseStream {
+		reversed = append(reversed, value)
+	}
+	slices.Reverse(reversed)
+
+	if !slices.Equal(reversed, expected) {
+		t.Fatalf("elements in reverse order are %v", reversed)
+	}
+}
+
+func TestRandomEdits(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	list := New[int]()
+	var expected []int
+
+	for i := range 2000 {
+		switch op := random.Intn(6); {
+		case op < 2 || len(expected) == 0:
+			index := random.Intn(len(expected) + 1)
+			list.insert(index, i)
+			expected = slices.Insert(expected, index, i)
+		case op == 2:
+			index := random.Intn(len(expected))
+			list.Remove(index)
+			expected = slices.Delete(expected, index, index+1)
+		case op == 3:
+			index := random.Intn(len(expected))
+			list.Set(index, -i)
+			expected[index] = -i
+		case op == 4:
+			index := random.Intn(len(expected))
+			it := list.ListIterator()
+			it.Seek(index)
+			if random.Intn(2) == 0 {
+				it.InsertBefore(i)
+				expected = slices.Insert(expected, index, i)
+			} else {
+				it.InsertAfter(i)
+				expected = slices.Insert(expected, index+1, i)
+			}
+
+			if it.Get() != expected[it.Index()] {
+				t.Fatalf("iterator points to %d at index %d", it.Get(), it.Index())
+			}
+		default:
+			it := list.ListIterator()
+			for index := 0; it.Valid(); index++ {
+				if it.Get() != expected[index] || it.Index() != index {
+					t.Fatalf("iterator has %d at index %d, expected %d", it.Get(), it.Index(), expected[index])
+				}
+
+				if random.Intn(8) == 0 {
+					it.Remove()
+					expected = slices.Delete(expected, index, index+1)
+					index--
+				} else {
+					it.Move()
+				}
+			}
+		}
+
+		if i%100 == 0 {
+			checkElements(t, list, expected)
+		}
+	}
+
+	checkElements(t, list, expected)
+}
+
+func TestIteratorInsertAtEnds(t *testing.T) {
+	list := FromValues(1, 2, 3)
+
+	it := list.ListIterator()
+	it.InsertBefore(0)
+	if it.Get() != 0 || it.Index() != 0 {
+		t.Errorf("InsertBefore() at the front moved to %d at index %d", it.Get(), it.Index())
+	}
+
+	it.InsertAfter(-1)
+	checkElements(t, list, []int{0, -1, 1, 2, 3})
+
+	it.SeekLast()
+	it.InsertAfter(5)
+	if it.Get() != 3 || it.Index() != 4 {
+		t.Errorf("InsertAfter() at the back moved to %d at index %d", it.Get(), it.Index())
+	}
+
+	it.InsertBefore(4)
+	if it.Get() != 4 || it.Index() != 4 {
+		t.Errorf("InsertBefore() at the back moved to %d at index %d", it.Get(), it.Index())
+	}
+	checkElements(t, list, []int{0, -1, 1, 2, 4, 3, 5})
+
+	it.SeekLast()
+	it.Remove()
+	if it.Valid() {
+		t.Error("iterator is valid after removing the last element")
+	}
+	checkElements(t, list, []int{0, -1, 1, 2, 4, 3})
+
+	it.SeekFirst()
+	it.Remove()
+	if it.Get() != -1 || it.Index() != 0 {
+		t.Errorf("Remove() at the front moved to %d at index %d", it.Get(), it.Index())
+	}
+	checkElements(t, list, []int{-1, 1, 2, 4, 3})
+}
+
+func TestIteratorSeekOutOfRange(t *testing.T) {
+	list := FromValues(1, 2, 3)
+	it := list.ListIterator()
+
+	for _, index := range []int{-1, 3, 10} {
+		it.Seek(index)
+		if it.Valid() || it.Index() != list.Size() {
+			t.Errorf("Seek(%d) is valid at index %d", index, it.Index())
+		}
+
+		for name, modify := range map[string]func(){
+			"InsertBefore": func() { it.InsertBefore(0) },
+			"InsertAfter":  func() { it.InsertAfter(0) },
+			"Remove":       it.Remove,
+		} {
+			func() {
+				defer func() {
+					err, _ := recover().(error)
+					if !errors.As(err, new(cols.IndexOutOfBoundsError)) {
+						t.Errorf("%s() after Seek(%d) recovered %v", name, index, err)
+					}
+				}()
+
+				modify()
+			}()
+		}
+
+		checkElements(t, list, []int{1, 2, 3})
+	}
+
+	it.Seek(1)
+	if !it.Valid() || it.Get() != 2 {
+		t.Error("Seek(1) after seeking out of range is not at 2")
+	}
+}
@@ -0,0 +1,30 @@
+package skiplist
+
+import (
+	"math/bits"
+	"math/rand/v2"
+)
+
+const maxLevel = 32
+
+// link points to the next node on one level.
+// Its width is the number of elements it skips over,
+// counting the node it points to. Links without
+// the next node point one past the last element.
+type link[T any] struct {
+	next  *node[T]
+	width int
+}
+
+type node[T any] struct {
+	value T
+
+	links []link[T]
+	prev  *node[T]
+}
+
+// randomLevel returns a level with the probability
+// of each next level being one quarter.
+func randomLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64())/2+1, maxLevel)
+}
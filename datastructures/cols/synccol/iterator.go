@@ -2,74 +2,177 @@ package synccol
 
 import (
 	"github.com/djordje200179/extendedlibrary/datastructures/cols"
-	"sync"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
 )
 
 // Iterator is a wrapper around a cols.Iterator
 // that provides thread-safe access.
+//
+// If the collection is copied because of a snapshot,
+// the iterator is moved to the same index in the copy
+// on its next use, so it always reads and writes
+// the current elements of the Wrapper.
+//
+// Copies of an Iterator share the same position.
+// The Wrapper can be shared between goroutines,
+// but an Iterator must not be.
 type Iterator[T any] struct {
-	colIt cols.Iterator[T]
+	*position[T]
+	wrapper *Wrapper[T]
+}
+
+type position[T any] struct {
+	colIt      cols.Iterator[T]
+	collection cols.Collection[T]
+	version    uint64
+}
 
-	mutex *sync.RWMutex
+// current moves the iterator to the collection of the Wrapper
+// if the collection was copied since the iterator was last used.
+// It must be called while holding the write lock.
+func (it Iterator[T]) current() cols.Iterator[T] {
+	w := it.wrapper
+	if it.version == w.version {
+		return it.colIt
+	}
+
+	index := w.collection.Size()
+	if it.colIt.Valid() {
+		index = indexOf(it.collection, it.colIt)
+	}
+
+	it.colIt = iteratorAt(w.collection, index)
+	it.collection = w.collection
+	it.version = w.version
+
+	return it.colIt
+}
+
+// lock locks the Wrapper for reading and returns the underlying iterator
+// with the function that unlocks the Wrapper.
+// If the iterator has to be moved to the current collection,
+// the Wrapper is locked for writing instead.
+func (it Iterator[T]) lock() (cols.Iterator[T], func()) {
+	mutex := &it.wrapper.mutex
+
+	mutex.RLock()
+	if it.version == it.wrapper.version {
+		return it.colIt, mutex.RUnlock
+	}
+	mutex.RUnlock()
+
+	mutex.Lock()
+	return it.current(), mutex.Unlock
 }
 
 // Valid returns if the iterator is
 // currently pointing to a valid element.
-func (it Iterator[T]) Valid() bool { return it.colIt.Valid() }
+func (it Iterator[T]) Valid() bool {
+	colIt, unlock := it.lock()
+	defer unlock()
+
+	return colIt.Valid()
+}
 
 // Move moves to the next element.
-func (it Iterator[T]) Move() { it.colIt.Move() }
+func (it Iterator[T]) Move() {
+	colIt, unlock := it.lock()
+	defer unlock()
+
+	colIt.Move()
+}
+
+// modifiable returns the underlying iterator
+// that can be used for modifications.
+// It must be called while holding the write lock.
+func (it Iterator[T]) modifiable() cols.Iterator[T] {
+	it.wrapper.modifiable()
+	return it.current()
+}
+
+func indexOf[T any](collection cols.Collection[T], colIt cols.Iterator[T]) int {
+	if randomIt, ok := colIt.(iter.RandomAccessIterator[T]); ok {
+		return randomIt.Index()
+	}
+
+	if !colIt.Valid() {
+		return collection.Size()
+	}
+
+	ref, index := colIt.GetRef(), 0
+	for it := collection.CollectionIterator(); it.Valid() && it.GetRef() != ref; it.Move() {
+		index++
+	}
+
+	return index
+}
+
+func iteratorAt[T any](collection cols.Collection[T], index int) cols.Iterator[T] {
+	colIt := collection.CollectionIterator()
+	if randomIt, ok := colIt.(iter.RandomAccessIterator[T]); ok {
+		randomIt.Seek(index)
+	} else {
+		for range index {
+			colIt.Move()
+		}
+	}
+
+	return colIt
+}
 
 // GetRef returns a reference to the current element.
 //
 // Usage of this method is discouraged, as it breaks the thread-safety.
 // Lock will not be held while the reference is used, so it is possible
 // that the value of the element changes while the reference is used.
+// The reference must not be used after the next write through the Wrapper.
 func (it Iterator[T]) GetRef() *T {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
 
-	return it.colIt.GetRef()
+	ref := it.modifiable().GetRef()
+	it.wrapper.referenceEscaped.Store(true)
+	return ref
 }
 
 // Get returns the current element.
 func (it Iterator[T]) Get() T {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
+	colIt, unlock := it.lock()
+	defer unlock()
 
-	return it.colIt.Get()
+	return colIt.Get()
 }
 
 // Set sets the current element.
 func (it Iterator[T]) Set(value T) {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
 
-	it.colIt.Set(value)
+	it.modifiable().Set(value)
 }
 
 // InsertBefore inserts the specified element
 // before the current element.
 func (it Iterator[T]) InsertBefore(value T) {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
 
-	it.colIt.InsertBefore(value)
+	it.modifiable().InsertBefore(value)
 }
 
 // InsertAfter inserts the specified element
 // after the current element.
 func (it Iterator[T]) InsertAfter(value T) {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
 
-	it.colIt.InsertAfter(value)
+	it.modifiable().InsertAfter(value)
 }
 
 // Remove removes the current element.
 func (it Iterator[T]) Remove() {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
 
-	it.colIt.Remove()
+	it.modifiable().Remove()
 }
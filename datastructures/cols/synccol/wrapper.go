@@ -2,10 +2,12 @@ package synccol
 
 import (
 	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/readcol"
 	"github.com/djordje200179/extendedlibrary/datastructures/iter"
 	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
 	"github.com/djordje200179/extendedlibrary/misc/functions/predication"
 	"sync"
+	"sync/atomic"
 )
 
 // Wrapper is a wrapper around a cols.Collection
@@ -14,15 +16,66 @@ import (
 // Locking is done through read-write mutex.
 // This means that multiple goroutines can read
 // at the same time, but only one goroutine can write at that time.
+//
+// Snapshots share the underlying cols.Collection with the Wrapper,
+// so taking one is O(1) and reading it never blocks writers.
+// The first write after a snapshot copies the collection once,
+// and later writes modify the copy in place.
+//
+// A reference returned by GetRef or FindRef
+// can be used until the next write through the Wrapper.
+// Until then, snapshots are copied instead of shared,
+// so writes through the reference are not visible in them.
 type Wrapper[T any] struct {
 	collection cols.Collection[T]
 
-	mutex sync.RWMutex
+	mutex   sync.RWMutex
+	version uint64
+
+	shared            atomic.Bool
+	snapshotStreaming atomic.Bool
+	referenceEscaped  atomic.Bool
 }
 
 // From creates a new Wrapper around the given cols.Collection.
 func From[T any](collection cols.Collection[T]) *Wrapper[T] {
-	return &Wrapper[T]{collection, sync.RWMutex{}}
+	return &Wrapper[T]{collection: collection}
+}
+
+// modifiable returns the collection that can be modified in place.
+// If the collection is shared with snapshots, it is copied first.
+// It must be called while holding the write lock.
+func (w *Wrapper[T]) modifiable() cols.Collection[T] {
+	if w.shared.Load() {
+		w.collection = w.collection.Clone()
+		w.version++
+		w.shared.Store(false)
+	}
+
+	w.referenceEscaped.Store(false)
+	return w.collection
+}
+
+// Snapshot returns a read-only view of the elements
+// at the moment of the call. Later changes to the
+// Wrapper are not reflected in the snapshot.
+func (w *Wrapper[T]) Snapshot() readcol.Wrapper[T] {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	if w.referenceEscaped.Load() {
+		return readcol.From(w.collection.Clone())
+	}
+
+	w.shared.Store(true)
+	return readcol.From(w.collection)
+}
+
+// SetSnapshotStreaming sets whether Stream and Stream2
+// iterate over a Snapshot instead of holding the read lock
+// for the whole iteration, so writers are never blocked by them.
+func (w *Wrapper[T]) SetSnapshotStreaming(enabled bool) {
+	w.snapshotStreaming.Store(enabled)
 }
 
 // Size returns the number of elements.
@@ -46,11 +99,14 @@ func (w *Wrapper[T]) Get(index int) T {
 // Usage of this method is discouraged, as it breaks the thread-safety.
 // Lock will not be held while the reference is used, so it is possible
 // that the value of the element changes while the reference is used.
+// The reference must not be used after the next write through the Wrapper.
 func (w *Wrapper[T]) GetRef(index int) *T {
-	w.mutex.RLock()
-	defer w.mutex.RUnlock()
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
 
-	return w.collection.GetRef(index)
+	ref := w.modifiable().GetRef(index)
+	w.referenceEscaped.Store(true)
+	return ref
 }
 
 // Set sets the element at the specified index.
@@ -58,7 +114,7 @@ func (w *Wrapper[T]) Set(index int, value T) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Set(index, value)
+	w.modifiable().Set(index, value)
 }
 
 // Update calculates and sets the new value
@@ -68,7 +124,8 @@ func (w *Wrapper[T]) Update(index int, updateFunction func(value T) T) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Set(index, updateFunction(w.collection.Get(index)))
+	collection := w.modifiable()
+	collection.Set(index, updateFunction(collection.Get(index)))
 }
 
 // UpdateRef updates the value at the given index
@@ -77,7 +134,7 @@ func (w *Wrapper[T]) UpdateRef(index int, updateFunction func(value *T)) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	updateFunction(w.collection.GetRef(index))
+	updateFunction(w.modifiable().GetRef(index))
 }
 
 // Prepend inserts the specified element at the beginning.
@@ -85,7 +142,7 @@ func (w *Wrapper[T]) Prepend(value T) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Prepend(value)
+	w.modifiable().Prepend(value)
 }
 
 // Append appends the specified element to the end.
@@ -93,7 +150,7 @@ func (w *Wrapper[T]) Append(value T) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Append(value)
+	w.modifiable().Append(value)
 }
 
 // Insert inserts the specified element at the specified index.
@@ -101,7 +158,7 @@ func (w *Wrapper[T]) Insert(index int, value T) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Insert(index, value)
+	w.modifiable().Insert(index, value)
 }
 
 // Remove removes the element at the specified index.
@@ -109,7 +166,7 @@ func (w *Wrapper[T]) Remove(index int) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Remove(index)
+	w.modifiable().Remove(index)
 }
 
 // Clear removes all elements.
@@ -117,7 +174,7 @@ func (w *Wrapper[T]) Clear() {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Clear()
+	w.modifiable().Clear()
 }
 
 // Reverse reverses the order of the elements.
@@ -125,7 +182,7 @@ func (w *Wrapper[T]) Reverse() {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Reverse()
+	w.modifiable().Reverse()
 }
 
 // Sort sorts the elements by the specified comparator.
@@ -133,7 +190,7 @@ func (w *Wrapper[T]) Sort(comparator comparison.Comparator[T]) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Sort(comparator)
+	w.modifiable().Sort(comparator)
 }
 
 // Join moves all elements from the other cols.Collection
@@ -142,7 +199,7 @@ func (w *Wrapper[T]) Join(other cols.Collection[T]) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.collection.Join(other)
+	w.modifiable().Join(other)
 }
 
 // Clone returns a new Wrapper with
@@ -152,7 +209,7 @@ func (w *Wrapper[T]) Clone() cols.Collection[T] {
 	defer w.mutex.RUnlock()
 
 	clonedCollection := w.collection.Clone()
-	return &Wrapper[T]{clonedCollection, sync.RWMutex{}}
+	return From(clonedCollection)
 }
 
 // Iterator returns a read-only iter.Iterator over the elements.
@@ -163,7 +220,10 @@ func (w *Wrapper[T]) Iterator() iter.Iterator[T] {
 // CollectionIterator returns an Iterator over the elements.
 // It can be used to modify the elements while iterating.
 func (w *Wrapper[T]) CollectionIterator() cols.Iterator[T] {
-	return Iterator[T]{w.collection.CollectionIterator(), &w.mutex}
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return Iterator[T]{&position[T]{w.collection.CollectionIterator(), w.collection, w.version}, w}
 }
 
 // Stream streams all elements.
@@ -171,6 +231,11 @@ func (w *Wrapper[T]) CollectionIterator() cols.Iterator[T] {
 // Updates to the underlying cols.Collection
 // while streaming are not allowed.
 func (w *Wrapper[T]) Stream(yield func(T) bool) {
+	if w.snapshotStreaming.Load() {
+		w.Snapshot().Stream(yield)
+		return
+	}
+
 	w.mutex.RLock()
 	defer w.mutex.RUnlock()
 
@@ -182,6 +247,11 @@ func (w *Wrapper[T]) Stream(yield func(T) bool) {
 // Updates to the underlying collection
 // while streaming are not allowed.
 func (w *Wrapper[T]) Stream2(yield func(int, T) bool) {
+	if w.snapshotStreaming.Load() {
+		w.Snapshot().Stream2(yield)
+		return
+	}
+
 	w.mutex.RLock()
 	defer w.mutex.RUnlock()
 
@@ -203,11 +273,14 @@ func (w *Wrapper[T]) FindIndex(predicate predication.Predicate[T]) (int, bool) {
 // Usage of this method is discouraged, as it breaks the thread-safety.
 // Lock will not be held while the reference is used, so it is possible
 // that the value of the element changes while the reference is used.
+// The reference must not be used after the next write through the Wrapper.
 func (w *Wrapper[T]) FindRef(predicate predication.Predicate[T]) (*T, bool) {
-	w.mutex.RLock()
-	defer w.mutex.RUnlock()
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
 
-	return w.collection.FindRef(predicate)
+	ref, ok := w.modifiable().FindRef(predicate)
+	w.referenceEscaped.Store(true)
+	return ref, ok
 }
 
 // Transaction executes the given function
@@ -217,5 +290,5 @@ func (w *Wrapper[T]) Transaction(updateFunction func(collection cols.Collection[
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	updateFunction(w.collection)
+	updateFunction(w.modifiable())
 }
@@ -0,0 +1,149 @@
+package synccol
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/array"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/linklist"
+	"slices"
+	"testing"
+)
+
+func TestSnapshotIsolation(t *testing.T) {
+	w := From[int](array.FromValues(1, 2, 3))
+
+	snapshot := w.Snapshot()
+	w.Snapshot()
+	if w.version != 0 {
+		t.Error("collection was copied without a write")
+	}
+
+	w.Append(4)
+	w.Set(0, 10)
+	if w.version != 1 {
+		t.Errorf("collection was copied %d times for writes after a snapshot", w.version)
+	}
+
+	if snapshot.Size() != 3 || snapshot.Get(0) != 1 {
+		t.Error("snapshot reflects later writes")
+	}
+
+	if latest := w.Snapshot(); latest.Size() != 4 || latest.Get(0) != 10 {
+		t.Error("new snapshot does not reflect the writes")
+	}
+}
+
+func TestSnapshotAfterReference(t *testing.T) {
+	w := From[int](array.FromValues(1, 2, 3))
+
+	ref := w.GetRef(1)
+	snapshot := w.Snapshot()
+	*ref = 20
+
+	if snapshot.Get(1) != 2 {
+		t.Errorf("snapshot reflects a write through reference, has %d", snapshot.Get(1))
+	}
+
+	w.Append(4)
+	w.Snapshot()
+	if !w.shared.Load() {
+		t.Error("snapshot is not shared after a write")
+	}
+}
+
+func TestIteratorAfterSnapshot(t *testing.T) {
+	for name, w := range map[string]*Wrapper[int]{
+		"array":    From[int](array.FromValues(1, 2, 3, 4)),
+		"linklist": From[int](linklist.NewFromIterable[int](array.FromValues(1, 2, 3, 4))),
+	} {
+		it := w.CollectionIterator()
+		it.Move()
+
+		snapshot := w.Snapshot()
+		it.Set(20)
+		it.Move()
+		it.Remove()
+		it.InsertBefore(30)
+
+		if values := slices.Collect(w.Stream); !slices.Equal(values, []int{1, 20, 30, 4}) {
+			t.Errorf("%s: wrapper has %v", name, values)
+		}
+
+		if values := slices.Collect(snapshot.Stream); !slices.Equal(values, []int{1, 2, 3, 4}) {
+			t.Errorf("%s: snapshot has %v", name, values)
+		}
+	}
+}
+
+func TestIteratorAfterCopyByWrapper(t *testing.T) {
+	for name, w := range map[string]*Wrapper[int]{
+		"array":    From[int](array.FromValues(1, 2, 3)),
+		"linklist": From[int](linklist.NewFromIterable[int](array.FromValues(1, 2, 3))),
+	} {
+		it := w.CollectionIterator()
+		it.Move()
+
+		snapshot := w.Snapshot()
+		w.Set(1, 10)
+
+		if value := it.Get(); value != 10 {
+			t.Errorf("%s: Get() = %d after the wrapper was copied", name, value)
+		}
+
+		it.Set(20)
+		if value := w.Get(1); value != 20 {
+			t.Errorf("%s: Set() through the iterator left %d in the wrapper", name, value)
+		}
+
+		w.Snapshot()
+		w.Append(4)
+		it.Move()
+		it.Move()
+
+		if !it.Valid() || it.Get() != 4 {
+			t.Errorf("%s: iterator doesn't reach the element appended after the copy", name)
+		}
+
+		if values := slices.Collect(snapshot.Stream); !slices.Equal(values, []int{1, 2, 3}) {
+			t.Errorf("%s: snapshot has %v", name, values)
+		}
+	}
+}
+
+func TestSnapshotDoesNotBlockWriters(t *testing.T) {
+	w := From[int](array.FromValues(0))
+	w.SetSnapshotStreaming(true)
+
+	streaming := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range w.Stream {
+			close(streaming)
+			<-release
+		}
+	}()
+
+	<-streaming
+	for i := range 100 {
+		w.Append(i)
+	}
+	close(release)
+	<-done
+
+	if w.Size() != 101 {
+		t.Errorf("size = %d", w.Size())
+	}
+}
+
+func TestSnapshotStreaming(t *testing.T) {
+	w := From[int](array.FromValues(1, 2, 3))
+	w.SetSnapshotStreaming(true)
+
+	for value := range w.Stream {
+		w.Append(value * 10)
+	}
+
+	if w.Size() != 6 || w.Get(-1) != 30 {
+		t.Errorf("size = %d, last = %d", w.Size(), w.Get(-1))
+	}
+}
@@ -0,0 +1,93 @@
+// Package clocktest implements a clock for the tests
+// of the types that expire their entries.
+package clocktest
+
+import (
+	"sync"
+	"time"
+)
+
+type manualTicker struct {
+	interval time.Duration
+	next     time.Time
+
+	ticks chan time.Time
+}
+
+// ManualClock is a clock whose time
+// only changes when it is advanced.
+// It is safe for concurrent use.
+type ManualClock struct {
+	mutex sync.Mutex
+
+	now     time.Time
+	tickers map[*manualTicker]struct{}
+}
+
+// NewManualClock creates a ManualClock set to the given time.
+func NewManualClock(now time.Time) *ManualClock {
+	return &ManualClock{
+		now:     now,
+		tickers: make(map[*manualTicker]struct{}),
+	}
+}
+
+// Now returns the current time of the clock.
+func (clock *ManualClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	return clock.now
+}
+
+// Advance moves the clock forward by the given duration.
+// Tickers whose interval has passed receive a single tick,
+// just like time.Ticker drops ticks for slow receivers.
+func (clock *ManualClock) Advance(duration time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.now = clock.now.Add(duration)
+
+	for ticker := range clock.tickers {
+		if clock.now.Before(ticker.next) {
+			continue
+		}
+
+		select {
+		case ticker.ticks <- clock.now:
+		default:
+		}
+
+		elapsed := clock.now.Sub(ticker.next)
+		ticker.next = ticker.next.Add((elapsed/ticker.interval + 1) * ticker.interval)
+	}
+}
+
+// NewTicker returns a channel that receives the time of the clock
+// whenever it is advanced past the next interval,
+// and a function that stops the ticks.
+//
+// Panic occurs if the interval is not positive.
+func (clock *ManualClock) NewTicker(interval time.Duration) (<-chan time.Time, func()) {
+	if interval <= 0 {
+		panic("non-positive interval for NewTicker")
+	}
+
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	ticker := &manualTicker{
+		interval: interval,
+		next:     clock.now.Add(interval),
+		ticks:    make(chan time.Time, 1),
+	}
+	clock.tickers[ticker] = struct{}{}
+
+	return ticker.ticks, func() {
+		clock.mutex.Lock()
+		defer clock.mutex.Unlock()
+
+		delete(clock.tickers, ticker)
+	}
+}
@@ -0,0 +1,217 @@
+// Package itertest implements checks that are shared
+// by the tests of the iterators and the reverse streams.
+package itertest
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"slices"
+	"testing"
+)
+
+func walk[T any](it iter.BidirectionalIterator[T], forward bool) []T {
+	var values []T
+	for it.Valid() {
+		values = append(values, it.Get())
+
+		if forward {
+			it.Move()
+		} else {
+			it.MoveBack()
+		}
+	}
+
+	return values
+}
+
+func checkStaysInvalid[T any](t *testing.T, it iter.BidirectionalIterator[T], end string) {
+	t.Helper()
+
+	it.MoveBack()
+	if it.Valid() {
+		t.Errorf("MoveBack() after moving past the %s made the iterator valid", end)
+	}
+
+	it.Move()
+	if it.Valid() {
+		t.Errorf("Move() after moving past the %s made the iterator valid", end)
+	}
+}
+
+// CheckBidirectional checks that the iterator walks over
+// the expected elements in both directions and that moving it
+// after it became invalid keeps it invalid.
+//
+// If the iterator is an iter.RandomAccessIterator,
+// Seek and Index are checked too.
+func CheckBidirectional[T comparable](t *testing.T, it iter.BidirectionalIterator[T], expected []T) {
+	t.Helper()
+
+	it.SeekFirst()
+	if got := walk(it, true); !slices.Equal(got, expected) {
+		t.Errorf("forward walk = %v, expected %v", got, expected)
+	}
+	checkStaysInvalid(t, it, "last element")
+
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+
+	it.SeekLast()
+	if got := walk(it, false); !slices.Equal(got, reversed) {
+		t.Errorf("backward walk = %v, expected %v", got, reversed)
+	}
+	checkStaysInvalid(t, it, "first element")
+
+	randomAccess, ok := it.(iter.RandomAccessIterator[T])
+	if !ok {
+		return
+	}
+
+	for i, value := range expected {
+		randomAccess.Seek(i)
+		if !randomAccess.Valid() || randomAccess.Get() != value || randomAccess.Index() != i {
+			t.Errorf("Seek(%d) points to index %d", i, randomAccess.Index())
+		}
+	}
+
+	for _, index := range []int{-1, len(expected)} {
+		randomAccess.Seek(index)
+		if randomAccess.Valid() {
+			t.Errorf("Seek(%d) made the iterator valid", index)
+		}
+		if randomAccess.Index() != len(expected) {
+			t.Errorf("Index() after Seek(%d) = %d", index, randomAccess.Index())
+		}
+	}
+
+	if len(expected) > 0 {
+		randomAccess.SeekLast()
+		if randomAccess.Index() != len(expected)-1 {
+			t.Errorf("SeekLast() moved to index %d", randomAccess.Index())
+		}
+	}
+}
+
+// CheckDistance checks Distance between every pair
+// of iterators created by newIterator and seeked to
+// the indices of the expected elements or past them,
+// and that an iterator that became invalid by moving back
+// from the first element is at the same index as the others.
+func CheckDistance[T any](t *testing.T, newIterator func() iter.RandomAccessIterator[T], expected []T) {
+	t.Helper()
+
+	first, second := newIterator(), newIterator()
+	for i := range len(expected) + 1 {
+		for j := range len(expected) + 1 {
+			first.Seek(i)
+			second.Seek(j)
+			if distance := first.Distance(second); distance != j-i {
+				t.Errorf("distance from index %d to index %d is %d", i, j, distance)
+			}
+		}
+	}
+
+	if len(expected) == 0 {
+		return
+	}
+
+	first.SeekFirst()
+	first.MoveBack()
+	for j := range len(expected) {
+		second.Seek(j)
+		if distance := second.Distance(first); distance != len(expected)-j {
+			t.Errorf("distance from index %d to an invalid iterator is %d", j, distance)
+		}
+	}
+}
+
+// Sequence is an indexed collection
+// whose elements can be streamed in reverse.
+type Sequence[T any] interface {
+	ReverseStream(yield func(T) bool)
+	ReverseStream2(yield func(int, T) bool)
+}
+
+// CheckSequence runs the checks of this package on the sequence
+// that holds the expected elements, using the iterators
+// created by newIterator. If they are iter.RandomAccessIterators,
+// Distance is checked too.
+func CheckSequence[T comparable](t *testing.T, sequence Sequence[T], newIterator func() iter.BidirectionalIterator[T], expected []T) {
+	t.Helper()
+
+	CheckBidirectional(t, newIterator(), expected)
+
+	if _, ok := newIterator().(iter.RandomAccessIterator[T]); ok {
+		newRandomAccess := func() iter.RandomAccessIterator[T] {
+			return newIterator().(iter.RandomAccessIterator[T])
+		}
+
+		CheckDistance(t, newRandomAccess, expected)
+	}
+
+	indices := make([]int, len(expected))
+	for i := range indices {
+		indices[i] = i
+	}
+
+	CheckReverseStream(t, sequence.ReverseStream, expected)
+	CheckReverseStream2(t, sequence.ReverseStream2, indices, expected)
+}
+
+// Squares returns the squares of the first n non-negative integers.
+func Squares(n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = i * i
+	}
+
+	return values
+}
+
+// CheckReverseStream checks that the stream yields
+// the expected elements in reverse order and that it stops
+// when the yield function returns false.
+func CheckReverseStream[T comparable](t *testing.T, stream func(yield func(T) bool), expected []T) {
+	t.Helper()
+
+	var got []T
+	for value := range stream {
+		got = append(got, value)
+	}
+
+	reversed := slices.Clone(expected)
+	slices.Reverse(reversed)
+
+	if !slices.Equal(got, reversed) {
+		t.Errorf("reverse stream = %v, expected %v", got, reversed)
+	}
+
+	// Range-over-func panics if the stream continues after the break.
+	for range stream {
+		break
+	}
+}
+
+// CheckReverseStream2 checks that the stream yields
+// the expected pairs in reverse order and that it stops
+// when the yield function returns false.
+func CheckReverseStream2[K, V comparable](t *testing.T, stream func(yield func(K, V) bool), expectedKeys []K, expectedValues []V) {
+	t.Helper()
+
+	var keys []K
+	var values []V
+	for key, value := range stream {
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	slices.Reverse(keys)
+	slices.Reverse(values)
+
+	if !slices.Equal(keys, expectedKeys) || !slices.Equal(values, expectedValues) {
+		t.Errorf("reverse stream = %v, %v, expected %v, %v", keys, values, expectedKeys, expectedValues)
+	}
+
+	for range stream {
+		break
+	}
+}
@@ -0,0 +1,156 @@
+// Package jsonmap encodes and decodes maps as JSON objects
+// while preserving the order of their entries.
+//
+// Keys are converted to object names the same way encoding/json does it:
+// string kinds are used directly, encoding.TextMarshaler is used otherwise,
+// and integer kinds are formatted as decimal numbers.
+package jsonmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"reflect"
+	"strconv"
+)
+
+func encodeKey(key any) (string, error) {
+	value := reflect.ValueOf(key)
+	if value.Kind() == reflect.String {
+		return value.String(), nil
+	}
+
+	if marshaler, ok := key.(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		return string(text), err
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("json: unsupported map key type %T", key)
+	}
+}
+
+func decodeKey[K any](name string) (K, error) {
+	var key K
+
+	if unmarshaler, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := unmarshaler.UnmarshalText([]byte(name))
+		return key, err
+	}
+
+	value := reflect.ValueOf(&key).Elem()
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(name)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(name, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("json: invalid map key %q: %w", name, err)
+		}
+
+		value.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		number, err := strconv.ParseUint(name, 10, value.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("json: invalid map key %q: %w", name, err)
+		}
+
+		value.SetUint(number)
+	default:
+		return key, fmt.Errorf("json: unsupported map key type %T", key)
+	}
+
+	return key, nil
+}
+
+// Marshal encodes the streamed entries as a JSON object
+// whose members are in the order of the stream.
+func Marshal[K, V any](entries func(yield func(K, V) bool)) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	for key, value := range entries {
+		name, err := encodeKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		nameJSON, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		valueJSON, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		buf.Write(nameJSON)
+		buf.WriteByte(':')
+		buf.Write(valueJSON)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal decodes a JSON object into its entries
+// in the order the members appear.
+//
+// JSON null is decoded as an object without members.
+func Unmarshal[K, V any](data []byte) ([]misc.Pair[K, V], error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil {
+		return nil, nil
+	}
+
+	if token != json.Delim('{') {
+		return nil, fmt.Errorf("json: cannot unmarshal %v into a map", token)
+	}
+
+	var entries []misc.Pair[K, V]
+	for decoder.More() {
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := decodeKey[K](token.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, misc.MakePair(key, value))
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
@@ -14,6 +14,46 @@ type Iterator[T any] interface {
 	Get() T
 }
 
+// A BidirectionalIterator is an Iterator
+// that can also move backwards and jump to either end.
+type BidirectionalIterator[T any] interface {
+	Iterator[T]
+	// MoveBack moves to the previous element.
+	// Moving back from the first element makes the iterator invalid.
+	//
+	// Moving an invalid iterator in either direction does nothing,
+	// even if it went past the last element. It stays invalid
+	// until it is repositioned, for example by SeekFirst or SeekLast.
+	MoveBack()
+	// SeekFirst moves to the first element.
+	SeekFirst()
+	// SeekLast moves to the last element.
+	SeekLast()
+}
+
+// A RandomAccessIterator is a BidirectionalIterator
+// that knows its position and can jump to any other one.
+type RandomAccessIterator[T any] interface {
+	BidirectionalIterator[T]
+	// Seek moves to the element at the specified index.
+	// Seeking out of bounds makes the iterator invalid.
+	Seek(index int)
+	// Index returns the index of the current element,
+	// or the number of elements if the iterator is not valid.
+	Index() int
+	// Distance returns the number of moves needed
+	// to get from the current element to the element of the other iterator.
+	Distance(other RandomAccessIterator[T]) int
+}
+
+// A Seekable is a sorted container whose
+// iterators can be positioned by a key.
+type Seekable[K, T any] interface {
+	// SeekTo returns an iterator at the first element
+	// whose key is greater than or equal to the specified key.
+	SeekTo(key K) BidirectionalIterator[T]
+}
+
 // An Iterable is a potentially infinite supply of elements
 // that can be iterated through using an Iterator.
 type Iterable[T any] interface {
@@ -0,0 +1,125 @@
+package maps
+
+import "github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+
+// PutAll sets all entries of the source Map in the destination Map.
+// Existing values in the destination Map are overwritten.
+func PutAll[K, V any](destination, source Map[K, V]) {
+	for key, value := range source.Stream2 {
+		destination.Set(key, value)
+	}
+}
+
+// RemoveIf removes all entries that satisfy the given predicate.
+// Returns the number of removed entries.
+func RemoveIf[K, V any](m Map[K, V], predicate func(key K, value V) bool) int {
+	removed := 0
+	for it := m.MapIterator(); it.Valid(); {
+		if predicate(it.Key(), it.Value()) {
+			it.Remove()
+			removed++
+		} else {
+			it.Move()
+		}
+	}
+
+	return removed
+}
+
+// RetainKeys removes all entries whose keys are not contained in the given keys.
+// Any type with a Contains method, like Map or sets.Set, can be used as keys.
+// Returns the number of removed entries.
+func RetainKeys[K, V any](m Map[K, V], keys interface{ Contains(key K) bool }) int {
+	return RemoveIf(m, func(key K, _ V) bool {
+		return !keys.Contains(key)
+	})
+}
+
+// MergeWith sets all entries of the source Map in the destination Map.
+// If a key is present in both Maps, the new value is
+// calculated by the given resolver.
+// Old values of a Peeker are looked up with Peek,
+// so only setting the entry changes its order.
+func MergeWith[K, V any](destination, source Map[K, V], resolver func(key K, oldValue, newValue V) V) {
+	for key, value := range source.Stream2 {
+		if oldValue, ok := peek(destination, key); ok {
+			value = resolver(key, oldValue, value)
+		}
+
+		destination.Set(key, value)
+	}
+}
+
+func peek[K, V any](m Map[K, V], key K) (V, bool) {
+	if peeker, ok := m.(Peeker[K, V]); ok {
+		return peeker.Peek(key)
+	}
+
+	return m.TryGet(key)
+}
+
+// Equal returns true if both Maps have the same keys
+// and their values are equal by the given hashing.Equaler.
+// Values of a Peeker are looked up with Peek,
+// so the order of its entries doesn't change.
+func Equal[K, V any](first, second Map[K, V], equaler hashing.Equaler[V]) bool {
+	if first.Size() != second.Size() {
+		return false
+	}
+
+	for key, value := range first.Stream2 {
+		otherValue, ok := peek(second, key)
+		if !ok || !equaler(value, otherValue) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Invert sets every entry of the source Map
+// in the destination Map with the key and the value swapped.
+// If multiple keys have the same value, one of them is kept.
+func Invert[K, V any](destination Map[V, K], source Map[K, V]) {
+	for key, value := range source.Stream2 {
+		destination.Set(value, key)
+	}
+}
+
+// Difference holds the keys that differ between two Maps.
+type Difference[K any] struct {
+	Added   []K // keys present only in the second Map
+	Removed []K // keys present only in the first Map
+	Changed []K // keys present in both Maps with different values
+}
+
+// Empty returns true if the Maps had no differences.
+func (diff Difference[K]) Empty() bool {
+	return len(diff.Added) == 0 && len(diff.Removed) == 0 && len(diff.Changed) == 0
+}
+
+// Diff compares the first Map with the second one
+// and reports added, removed and changed keys.
+// Values are compared by the given hashing.Equaler.
+// Values of a Peeker are looked up with Peek,
+// so the order of its entries doesn't change.
+func Diff[K, V any](first, second Map[K, V], equaler hashing.Equaler[V]) Difference[K] {
+	var diff Difference[K]
+
+	for key, value := range first.Stream2 {
+		otherValue, ok := peek(second, key)
+		if !ok {
+			diff.Removed = append(diff.Removed, key)
+		} else if !equaler(value, otherValue) {
+			diff.Changed = append(diff.Changed, key)
+		}
+	}
+
+	for key := range second.Keys {
+		if !first.Contains(key) {
+			diff.Added = append(diff.Added, key)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,158 @@
+package maps_test
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/linkmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+	"slices"
+	"testing"
+)
+
+func newTree(entries map[int]string) *rbt.Tree[int, string] {
+	tree := rbt.New[int, string]()
+	for key, value := range entries {
+		tree.Set(key, value)
+	}
+
+	return tree
+}
+
+func checkEntries(t *testing.T, tree *rbt.Tree[int, string], keys []int, values []string) {
+	t.Helper()
+
+	if got := slices.Collect(tree.Keys); !slices.Equal(got, keys) {
+		t.Errorf("keys are %v, expected %v", got, keys)
+	}
+	if got := slices.Collect(tree.Values); !slices.Equal(got, values) {
+		t.Errorf("values are %v, expected %v", got, values)
+	}
+}
+
+func TestPutAllAndMergeWith(t *testing.T) {
+	destination := newTree(map[int]string{1: "a", 2: "b"})
+	source := newTree(map[int]string{2: "x", 3: "y"})
+
+	maps.PutAll[int, string](destination, source)
+	checkEntries(t, destination, []int{1, 2, 3}, []string{"a", "x", "y"})
+
+	maps.MergeWith[int, string](destination, source, func(key int, oldValue, newValue string) string {
+		return oldValue + newValue
+	})
+	checkEntries(t, destination, []int{1, 2, 3}, []string{"a", "xx", "yy"})
+
+	merged := newTree(nil)
+	maps.MergeWith[int, string](merged, source, func(int, string, string) string {
+		t.Error("resolver was called for a missing key")
+		return ""
+	})
+	checkEntries(t, merged, []int{2, 3}, []string{"x", "y"})
+}
+
+func TestRemoveIfAndRetainKeys(t *testing.T) {
+	tree := newTree(map[int]string{1: "a", 2: "b", 3: "c", 4: "d", 5: "e"})
+
+	removed := maps.RemoveIf[int, string](tree, func(key int, value string) bool {
+		return key%2 == 0 || value == "e"
+	})
+	if removed != 3 {
+		t.Errorf("RemoveIf() = %d", removed)
+	}
+	checkEntries(t, tree, []int{1, 3}, []string{"a", "c"})
+
+	keys := hashmap.New[int, bool]()
+	keys.Set(3, true)
+	keys.Set(7, true)
+
+	if removed := maps.RetainKeys[int, string](tree, keys); removed != 1 {
+		t.Errorf("RetainKeys() = %d", removed)
+	}
+	checkEntries(t, tree, []int{3}, []string{"c"})
+}
+
+func TestEqualAndDiff(t *testing.T) {
+	first := newTree(map[int]string{1: "a", 2: "b", 3: "c"})
+	second := newTree(map[int]string{2: "B", 3: "c", 4: "d"})
+	equaler := hashing.DefaultEqualer[string]()
+
+	diff := maps.Diff[int, string](first, second, equaler)
+	if !slices.Equal(diff.Added, []int{4}) || !slices.Equal(diff.Removed, []int{1}) || !slices.Equal(diff.Changed, []int{2}) {
+		t.Errorf("Diff() = %+v", diff)
+	}
+	if diff.Empty() || maps.Equal[int, string](first, second, equaler) {
+		t.Error("different maps are reported equal")
+	}
+
+	second.Set(1, "b")
+	second.Remove(4)
+	if maps.Equal[int, string](first, second, equaler) {
+		t.Error("maps with same keys and different values are reported equal")
+	}
+
+	caseless := func(first, second string) bool { return first == second || first == "b" && second == "B" }
+	second.Set(1, "a")
+	if !maps.Equal[int, string](first, second, caseless) || !maps.Diff[int, string](first, second, caseless).Empty() {
+		t.Error("equal maps are reported different")
+	}
+}
+
+func TestEqualWithDifferentKeys(t *testing.T) {
+	first := newTree(map[int]string{1: "a", 2: "b", 3: "c"})
+	second := newTree(map[int]string{1: "a", 2: "b", 4: "c"})
+	equaler := hashing.DefaultEqualer[string]()
+
+	if maps.Equal[int, string](first, second, equaler) || maps.Equal[int, string](second, first, equaler) {
+		t.Error("maps of the same size with different keys are reported equal")
+	}
+}
+
+func TestEqualKeepsOrder(t *testing.T) {
+	first := linkmap.NewHashmap[int, string](0, linkmap.LRU)
+	second := linkmap.NewHashmap[int, string](0, linkmap.LRU)
+	values := []string{"a", "b", "c"}
+	for i := range values {
+		first.Set(2-i, values[2-i])
+		second.Set(i, values[i])
+	}
+	equaler := hashing.DefaultEqualer[string]()
+
+	if !maps.Equal[int, string](first, second, equaler) || !maps.Diff[int, string](first, second, equaler).Empty() {
+		t.Error("equal maps are reported different")
+	}
+
+	if keys := slices.Collect(second.Keys); !slices.Equal(keys, []int{0, 1, 2}) {
+		t.Errorf("comparing reordered the keys to %v", keys)
+	}
+
+	destination := linkmap.NewHashmap[int, string](0, linkmap.LFU)
+	for i := range values {
+		destination.Set(i, values[i])
+	}
+	destination.Get(1)
+	destination.Get(1)
+
+	source := linkmap.NewHashmap[int, string](0, linkmap.FIFO)
+	source.Set(0, "d")
+	maps.MergeWith[int, string](destination, source, func(key int, oldValue, newValue string) string {
+		return oldValue + newValue
+	})
+
+	if keys := slices.Collect(destination.Keys); !slices.Equal(keys, []int{2, 0, 1}) {
+		t.Errorf("merging reordered the keys to %v", keys)
+	}
+	if value := destination.Get(0); value != "ad" {
+		t.Errorf("merged value is %q", value)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	source := newTree(map[int]string{1: "a", 2: "b"})
+
+	inverted := rbt.New[string, int]()
+	maps.Invert[int, string](inverted, source)
+
+	if inverted.Size() != 2 || inverted.Get("a") != 1 || inverted.Get("b") != 2 {
+		t.Errorf("inverted entries are %v", slices.Collect(inverted.Keys))
+	}
+}
@@ -0,0 +1,37 @@
+package bimap
+
+import "github.com/djordje200179/extendedlibrary/datastructures/maps"
+
+// Iterator is an iterator over a BiMap.
+type Iterator[K, V comparable] struct {
+	maps.Iterator[K, V]
+
+	bm *BiMap[K, V]
+}
+
+// SetValue sets the value of the current entry.
+//
+// Panic DuplicateValueError occurs if the value
+// is already associated with another key.
+func (it *Iterator[K, V]) SetValue(value V) {
+	key := it.Key()
+	if oldKey, ok := it.bm.backward.TryGet(value); ok {
+		if oldKey == key {
+			return
+		}
+
+		panic(DuplicateValueError[V]{Value: value})
+	}
+
+	it.bm.backward.Remove(it.Value())
+	it.Iterator.SetValue(value)
+	it.bm.backward.Set(value, key)
+}
+
+// Remove removes the current entry from the BiMap.
+// The iterator will point to the next entry afterward.
+func (it *Iterator[K, V]) Remove() {
+	value := it.Value()
+	it.Iterator.Remove()
+	it.bm.backward.Remove(value)
+}
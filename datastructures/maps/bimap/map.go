@@ -0,0 +1,229 @@
+package bimap
+
+import (
+	"cmp"
+	"fmt"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
+	"github.com/djordje200179/extendedlibrary/misc"
+)
+
+// DuplicateValueError is an error that is panicked when trying
+// to associate a value that is already associated with another key.
+type DuplicateValueError[V any] struct {
+	Value V // the value that was duplicated
+}
+
+// Error returns the error message.
+func (err DuplicateValueError[V]) Error() string {
+	return fmt.Sprintf("value %v is already present in bimap", err.Value)
+}
+
+// BiMap is a bidirectional map in which both keys and values are unique.
+// It is backed by two maps, one from keys to values and
+// one from values to keys, which are always kept in sync.
+type BiMap[K, V comparable] struct {
+	forward  maps.Map[K, V]
+	backward maps.Map[V, K]
+
+	inverse *BiMap[V, K]
+}
+
+// NewHashBiMap creates an empty BiMap backed by hashmaps.
+func NewHashBiMap[K, V comparable]() *BiMap[K, V] {
+	return FromMaps[K, V](hashmap.New[K, V](), hashmap.New[V, K]())
+}
+
+// NewTreeBiMap creates an empty BiMap backed by red-black trees.
+func NewTreeBiMap[K, V cmp.Ordered]() *BiMap[K, V] {
+	return FromMaps[K, V](rbt.New[K, V](), rbt.New[V, K]())
+}
+
+// FromMaps creates a BiMap with the entries of the forward map
+// that uses the backward map for lookups by value.
+// The backward map is cleared and filled
+// with the inverted entries of the forward map.
+//
+// Panic DuplicateValueError occurs if the forward map
+// contains the same value for multiple keys.
+func FromMaps[K, V comparable](forward maps.Map[K, V], backward maps.Map[V, K]) *BiMap[K, V] {
+	backward.Clear()
+	for key, value := range forward.Stream2 {
+		if backward.Contains(value) {
+			panic(DuplicateValueError[V]{Value: value})
+		}
+
+		backward.Set(value, key)
+	}
+
+	return newBiMap(forward, backward)
+}
+
+func newBiMap[K, V comparable](forward maps.Map[K, V], backward maps.Map[V, K]) *BiMap[K, V] {
+	bm := &BiMap[K, V]{
+		forward:  forward,
+		backward: backward,
+	}
+	bm.inverse = &BiMap[V, K]{
+		forward:  backward,
+		backward: forward,
+		inverse:  bm,
+	}
+
+	return bm
+}
+
+// Inverse returns the inverse view of the BiMap, which maps values to keys.
+// It shares the storage with the original BiMap,
+// so changes made through one are reflected in the other.
+func (bm *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return bm.inverse
+}
+
+// Size returns the number of entries in the BiMap.
+func (bm *BiMap[K, V]) Size() int {
+	return bm.forward.Size()
+}
+
+// Contains returns true if the BiMap contains the specified key.
+func (bm *BiMap[K, V]) Contains(key K) bool {
+	return bm.forward.Contains(key)
+}
+
+// ContainsValue returns true if the BiMap contains the specified value.
+func (bm *BiMap[K, V]) ContainsValue(value V) bool {
+	return bm.backward.Contains(value)
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (bm *BiMap[K, V]) TryGet(key K) (V, bool) {
+	return bm.forward.TryGet(key)
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (bm *BiMap[K, V]) Get(key K) V {
+	return bm.forward.Get(key)
+}
+
+// GetRef returns a reference to the value associated with the specified key.
+// Panics if the key is not present.
+//
+// Modifying the value through the reference
+// leaves the inverse view out of sync.
+func (bm *BiMap[K, V]) GetRef(key K) *V {
+	return bm.forward.GetRef(key)
+}
+
+// TryGetKey returns the key associated with the specified value,
+// or zero value and false if the value is not present.
+func (bm *BiMap[K, V]) TryGetKey(value V) (K, bool) {
+	return bm.backward.TryGet(value)
+}
+
+// GetKey returns the key associated with the specified value.
+// Panics if the value is not present.
+func (bm *BiMap[K, V]) GetKey(value V) K {
+	return bm.backward.Get(value)
+}
+
+// Set sets the value associated with the specified key.
+//
+// Panic DuplicateValueError occurs if the value
+// is already associated with another key.
+func (bm *BiMap[K, V]) Set(key K, value V) {
+	if oldKey, ok := bm.backward.TryGet(value); ok {
+		if oldKey == key {
+			return
+		}
+
+		panic(DuplicateValueError[V]{Value: value})
+	}
+
+	bm.put(key, value)
+}
+
+// ForceSet sets the value associated with the specified key.
+// If the value is already associated with another key,
+// that entry is removed first.
+func (bm *BiMap[K, V]) ForceSet(key K, value V) {
+	if oldKey, ok := bm.backward.TryGet(value); ok {
+		if oldKey == key {
+			return
+		}
+
+		bm.forward.Remove(oldKey)
+		bm.backward.Remove(value)
+	}
+
+	bm.put(key, value)
+}
+
+func (bm *BiMap[K, V]) put(key K, value V) {
+	if oldValue, ok := bm.forward.TryGet(key); ok {
+		bm.backward.Remove(oldValue)
+	}
+
+	bm.forward.Set(key, value)
+	bm.backward.Set(value, key)
+}
+
+// Remove removes the entry with the specified key.
+// Does nothing if the key is not present.
+func (bm *BiMap[K, V]) Remove(key K) {
+	value, ok := bm.forward.TryGet(key)
+	if !ok {
+		return
+	}
+
+	bm.forward.Remove(key)
+	bm.backward.Remove(value)
+}
+
+// RemoveValue removes the entry with the specified value.
+// Does nothing if the value is not present.
+func (bm *BiMap[K, V]) RemoveValue(value V) {
+	bm.inverse.Remove(value)
+}
+
+// Clear removes all entries from the BiMap.
+func (bm *BiMap[K, V]) Clear() {
+	bm.forward.Clear()
+	bm.backward.Clear()
+}
+
+// Clone returns a shallow copy of the BiMap.
+func (bm *BiMap[K, V]) Clone() maps.Map[K, V] {
+	return newBiMap(bm.forward.Clone(), bm.backward.Clone())
+}
+
+// Iterator returns an iter.Iterator over the BiMap.
+func (bm *BiMap[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return bm.MapIterator()
+}
+
+// MapIterator returns an iterator over the BiMap.
+func (bm *BiMap[K, V]) MapIterator() maps.Iterator[K, V] {
+	return &Iterator[K, V]{
+		bm:       bm,
+		Iterator: bm.forward.MapIterator(),
+	}
+}
+
+// Stream2 streams over the entries in the BiMap.
+func (bm *BiMap[K, V]) Stream2(yield func(K, V) bool) {
+	bm.forward.Stream2(yield)
+}
+
+// Keys streams the keys of the BiMap.
+func (bm *BiMap[K, V]) Keys(yield func(K) bool) {
+	bm.forward.Keys(yield)
+}
+
+// Values streams the values of the BiMap.
+func (bm *BiMap[K, V]) Values(yield func(V) bool) {
+	bm.forward.Values(yield)
+}
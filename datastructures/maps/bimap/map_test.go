@@ -0,0 +1,140 @@
+package bimap
+
+import (
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"slices"
+	"testing"
+)
+
+func checkSync(t *testing.T, bm *BiMap[int, string]) {
+	t.Helper()
+
+	if bm.forward.Size() != bm.backward.Size() {
+		t.Fatalf("forward map has %d entries and backward map has %d", bm.forward.Size(), bm.backward.Size())
+	}
+
+	for key, value := range bm.Stream2 {
+		if bm.GetKey(value) != key {
+			t.Fatalf("value %q maps back to key %d instead of %d", value, bm.GetKey(value), key)
+		}
+	}
+}
+
+func checkDuplicatePanic(t *testing.T, value string, f func()) {
+	t.Helper()
+
+	defer func() {
+		var duplicateErr DuplicateValueError[string]
+		if err, _ := recover().(error); !errors.As(err, &duplicateErr) || duplicateErr.Value != value {
+			t.Errorf("panicked with %v, expected duplicate value %q", err, value)
+		}
+	}()
+
+	f()
+}
+
+func TestSet(t *testing.T) {
+	bm := NewTreeBiMap[int, string]()
+	bm.Set(1, "one")
+	bm.Set(2, "two")
+	bm.Set(1, "one")
+	bm.Set(1, "uno")
+	checkSync(t, bm)
+
+	if bm.ContainsValue("one") || bm.GetKey("uno") != 1 {
+		t.Error("old value of a key was not replaced")
+	}
+
+	checkDuplicatePanic(t, "two", func() { bm.Set(3, "two") })
+	if bm.Contains(3) || bm.Size() != 2 {
+		t.Errorf("failed Set() changed the bimap, size is %d", bm.Size())
+	}
+
+	bm.ForceSet(3, "two")
+	checkSync(t, bm)
+	if bm.Contains(2) || bm.GetKey("two") != 3 {
+		t.Error("ForceSet() didn't move the value to the new key")
+	}
+
+	bm.RemoveValue("uno")
+	bm.Remove(42)
+	checkSync(t, bm)
+	if keys := slices.Collect(bm.Keys); !slices.Equal(keys, []int{3}) {
+		t.Errorf("keys are %v", keys)
+	}
+}
+
+func TestInverse(t *testing.T) {
+	bm := NewHashBiMap[int, string]()
+	inverse := bm.Inverse()
+
+	bm.Set(1, "one")
+	inverse.Set("two", 2)
+	inverse.Set("one", 3)
+	checkSync(t, bm)
+
+	if bm.Get(3) != "one" || bm.Contains(1) || bm.Get(2) != "two" {
+		t.Error("writes through the inverse view are not visible")
+	}
+
+	if inverse.Inverse() != bm {
+		t.Error("inverse of the inverse view is not the original bimap")
+	}
+
+	checkDuplicatePanic(t, "two", func() { bm.Set(1, "two") })
+
+	inverse.Remove("two")
+	if bm.Contains(2) || inverse.Size() != 1 {
+		t.Errorf("Remove() through the inverse view left size %d", bm.Size())
+	}
+}
+
+func TestIterator(t *testing.T) {
+	bm := NewTreeBiMap[int, string]()
+	for i, value := range []string{"a", "b", "c", "d"} {
+		bm.Set(i, value)
+	}
+
+	for it := bm.MapIterator(); it.Valid(); {
+		switch it.Key() {
+		case 0:
+			it.SetValue("z")
+			it.Move()
+		case 1:
+			checkDuplicatePanic(t, "c", func() { it.SetValue("c") })
+			it.Remove()
+		default:
+			it.Move()
+		}
+	}
+
+	checkSync(t, bm)
+	if bm.ContainsValue("a") || bm.ContainsValue("b") || bm.GetKey("z") != 0 || bm.Size() != 3 {
+		t.Errorf("iterator left keys %v", slices.Collect(bm.Keys))
+	}
+}
+
+func TestFromMaps(t *testing.T) {
+	forward := hashmap.New[int, string]()
+	forward.Set(1, "one")
+	forward.Set(2, "two")
+
+	backward := hashmap.New[string, int]()
+	backward.Set("stale", 42)
+
+	bm := FromMaps[int, string](forward, backward)
+	checkSync(t, bm)
+	if bm.ContainsValue("stale") {
+		t.Error("backward map was not cleared")
+	}
+
+	cloned := bm.Clone().(*BiMap[int, string])
+	cloned.Set(3, "three")
+	if bm.Contains(3) || cloned.Inverse().Get("three") != 3 {
+		t.Error("clone shares the storage with the original")
+	}
+
+	forward.Set(3, "one")
+	checkDuplicatePanic(t, "one", func() { FromMaps[int, string](forward, hashmap.New[string, int]()) })
+}
@@ -0,0 +1,129 @@
+package btree
+
+import "github.com/djordje200179/extendedlibrary/misc"
+
+type frame[K, V any] struct {
+	node  *node[K, V]
+	index int
+}
+
+// Iterator is an iterator over a Tree.
+type Iterator[K, V any] struct {
+	tree *Tree[K, V]
+
+	path []frame[K, V]
+}
+
+func (it *Iterator[K, V]) top() *frame[K, V] {
+	return &it.path[len(it.path)-1]
+}
+
+func (it *Iterator[K, V]) descendLeftmost(curr *node[K, V]) {
+	for curr != nil {
+		it.path = append(it.path, frame[K, V]{curr, 0})
+
+		if curr.leaf() {
+			break
+		}
+
+		curr = curr.children[0]
+	}
+}
+
+func (it *Iterator[K, V]) ascend() {
+	for len(it.path) > 0 && it.top().index >= len(it.top().node.keys) {
+		it.path = it.path[:len(it.path)-1]
+	}
+}
+
+func (it *Iterator[K, V]) seekFirst() {
+	it.path = it.path[:0]
+	it.descendLeftmost(it.tree.root)
+}
+
+func (it *Iterator[K, V]) seek(key K, inclusive bool) {
+	it.path = it.path[:0]
+
+	for curr := it.tree.root; curr != nil; {
+		index, found := it.tree.search(curr, key)
+		if found {
+			if inclusive {
+				it.path = append(it.path, frame[K, V]{curr, index})
+				return
+			}
+
+			index++
+		}
+
+		it.path = append(it.path, frame[K, V]{curr, index})
+
+		if curr.leaf() {
+			break
+		}
+
+		curr = curr.children[index]
+	}
+
+	it.ascend()
+}
+
+// Valid returns true if it points to a valid entry.
+func (it *Iterator[K, V]) Valid() bool {
+	return len(it.path) > 0
+}
+
+// Move moves the iterator to the next entry.
+func (it *Iterator[K, V]) Move() {
+	if len(it.path) == 0 {
+		return
+	}
+
+	top := it.top()
+	top.index++
+
+	if !top.node.leaf() {
+		it.descendLeftmost(top.node.children[top.index])
+		return
+	}
+
+	it.ascend()
+}
+
+// Get returns the current entry as a key-value pair.
+func (it *Iterator[K, V]) Get() misc.Pair[K, V] {
+	return misc.MakePair(it.Key(), it.Value())
+}
+
+// Key returns the key of the current entry.
+func (it *Iterator[K, V]) Key() K {
+	top := it.top()
+	return top.node.keys[top.index]
+}
+
+// Value returns the value of the current entry.
+func (it *Iterator[K, V]) Value() V {
+	top := it.top()
+	return top.node.values[top.index]
+}
+
+// ValueRef returns a reference to the value of the current entry.
+//
+// The reference is valid only until the next modification of the Tree.
+func (it *Iterator[K, V]) ValueRef() *V {
+	top := it.top()
+	return &top.node.values[top.index]
+}
+
+// SetValue sets the value of the current entry.
+func (it *Iterator[K, V]) SetValue(value V) {
+	top := it.top()
+	top.node.values[top.index] = value
+}
+
+// Remove removes the current entry from the Tree.
+// The iterator will point to the next entry afterward.
+func (it *Iterator[K, V]) Remove() {
+	key := it.Key()
+	it.tree.Remove(key)
+	it.seek(key, false)
+}
@@ -0,0 +1,54 @@
+package btree
+
+import "slices"
+
+type node[K, V any] struct {
+	keys     []K
+	values   []V
+	children []*node[K, V]
+}
+
+func (n *node[K, V]) leaf() bool {
+	return len(n.children) == 0
+}
+
+func (n *node[K, V]) insertEntry(index int, key K, value V) {
+	n.keys = slices.Insert(n.keys, index, key)
+	n.values = slices.Insert(n.values, index, value)
+}
+
+func (n *node[K, V]) removeEntry(index int) (K, V) {
+	key, value := n.keys[index], n.values[index]
+
+	n.keys = slices.Delete(n.keys, index, index+1)
+	n.values = slices.Delete(n.values, index, index+1)
+
+	return key, value
+}
+
+func (n *node[K, V]) insertChild(index int, child *node[K, V]) {
+	n.children = slices.Insert(n.children, index, child)
+}
+
+func (n *node[K, V]) removeChild(index int) *node[K, V] {
+	child := n.children[index]
+	n.children = slices.Delete(n.children, index, index+1)
+
+	return child
+}
+
+func (n *node[K, V]) clone() *node[K, V] {
+	cloned := &node[K, V]{
+		keys:   slices.Clone(n.keys),
+		values: slices.Clone(n.values),
+	}
+
+	if !n.leaf() {
+		cloned.children = make([]*node[K, V], len(n.children))
+		for i, child := range n.children {
+			cloned.children[i] = child.clone()
+		}
+	}
+
+	return cloned
+}
@@ -0,0 +1,443 @@
+package btree
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"slices"
+)
+
+// DefaultDegree is the minimum degree used
+// by constructors that don't specify one.
+const DefaultDegree = 32
+
+// Tree is a B-tree implementation of a map.
+//
+// Every node stores between degree-1 and 2*degree-1 entries
+// in contiguous memory, so there are far fewer allocations
+// and pointer indirections than in a binary search tree.
+//
+// References to values are valid only until
+// the next modification of the Tree.
+type Tree[K, V any] struct {
+	root    *node[K, V]
+	entries int
+
+	degree     int
+	comparator comparison.Comparator[K]
+}
+
+// NewWithComparatorAndDegree creates an empty Tree with the specified comparator
+// and minimum degree.
+//
+// Panic occurs if the degree is less than 2.
+func NewWithComparatorAndDegree[K, V any](comparator comparison.Comparator[K], degree int) *Tree[K, V] {
+	if degree < 2 {
+		panic("degree must be at least 2")
+	}
+
+	return &Tree[K, V]{
+		degree:     degree,
+		comparator: comparator,
+	}
+}
+
+// NewWithComparator creates an empty Tree with the specified comparator.
+func NewWithComparator[K, V any](comparator comparison.Comparator[K]) *Tree[K, V] {
+	return NewWithComparatorAndDegree[K, V](comparator, DefaultDegree)
+}
+
+// NewWithDegree creates an empty Tree with the specified minimum degree
+// and the default comparator for ordered keys.
+//
+// Panic occurs if the degree is less than 2.
+func NewWithDegree[K cmp.Ordered, V any](degree int) *Tree[K, V] {
+	return NewWithComparatorAndDegree[K, V](cmp.Compare[K], degree)
+}
+
+// New creates an empty Tree with the default comparator for ordered keys.
+func New[K cmp.Ordered, V any]() *Tree[K, V] {
+	return NewWithComparator[K, V](cmp.Compare[K])
+}
+
+// NewWithComparatorFromIterable creates a Tree with the specified comparator from the specified iter.Iterable.
+func NewWithComparatorFromIterable[K, V any](comparator comparison.Comparator[K], iterable iter.Iterable[misc.Pair[K, V]]) *Tree[K, V] {
+	tree := NewWithComparator[K, V](comparator)
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		tree.Set(entry.First, entry.Second)
+	}
+
+	return tree
+}
+
+// NewFromIterable creates a Tree from the specified iter.Iterable.
+func NewFromIterable[K cmp.Ordered, V any](iterable iter.Iterable[misc.Pair[K, V]]) *Tree[K, V] {
+	return NewWithComparatorFromIterable[K, V](cmp.Compare[K], iterable)
+}
+
+// Degree returns the minimum degree of the Tree.
+func (tree *Tree[K, V]) Degree() int {
+	return tree.degree
+}
+
+func (tree *Tree[K, V]) search(n *node[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(n.keys, key, tree.comparator)
+}
+
+func (tree *Tree[K, V]) find(key K) (*node[K, V], int) {
+	for curr := tree.root; curr != nil; {
+		index, found := tree.search(curr, key)
+		if found {
+			return curr, index
+		}
+
+		if curr.leaf() {
+			break
+		}
+
+		curr = curr.children[index]
+	}
+
+	return nil, 0
+}
+
+// Size returns the number of entries in the Tree.
+func (tree *Tree[K, V]) Size() int {
+	return tree.entries
+}
+
+// Contains returns true if the Tree contains the specified key.
+func (tree *Tree[K, V]) Contains(key K) bool {
+	n, _ := tree.find(key)
+	return n != nil
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (tree *Tree[K, V]) TryGet(key K) (V, bool) {
+	n, index := tree.find(key)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	return n.values[index], true
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (tree *Tree[K, V]) Get(key K) V {
+	return *tree.GetRef(key)
+}
+
+// GetRef returns a reference to the value associated with the specified key.
+// Panics if the key is not present.
+//
+// The reference is valid only until the next modification of the Tree.
+func (tree *Tree[K, V]) GetRef(key K) *V {
+	n, index := tree.find(key)
+	if n == nil {
+		panic(maps.MissingKeyError[K]{Key: key})
+	}
+
+	return &n.values[index]
+}
+
+func (tree *Tree[K, V]) maxEntries() int {
+	return 2*tree.degree - 1
+}
+
+func (tree *Tree[K, V]) splitChild(parent *node[K, V], index int) {
+	child := parent.children[index]
+	middle := tree.degree - 1
+
+	sibling := &node[K, V]{
+		keys:   slices.Clone(child.keys[middle+1:]),
+		values: slices.Clone(child.values[middle+1:]),
+	}
+	if !child.leaf() {
+		sibling.children = slices.Clone(child.children[middle+1:])
+		clear(child.children[middle+1:])
+		child.children = child.children[:middle+1]
+	}
+
+	parent.insertEntry(index, child.keys[middle], child.values[middle])
+	parent.insertChild(index+1, sibling)
+
+	clear(child.keys[middle:])
+	clear(child.values[middle:])
+	child.keys = child.keys[:middle]
+	child.values = child.values[:middle]
+}
+
+// Set sets the value associated with the specified key.
+func (tree *Tree[K, V]) Set(key K, value V) {
+	if tree.root == nil {
+		tree.root = &node[K, V]{
+			keys:   []K{key},
+			values: []V{value},
+		}
+		tree.entries++
+
+		return
+	}
+
+	if len(tree.root.keys) == tree.maxEntries() {
+		tree.root = &node[K, V]{children: []*node[K, V]{tree.root}}
+		tree.splitChild(tree.root, 0)
+	}
+
+	for curr := tree.root; ; {
+		index, found := tree.search(curr, key)
+		if found {
+			curr.values[index] = value
+			return
+		}
+
+		if curr.leaf() {
+			curr.insertEntry(index, key, value)
+			tree.entries++
+			return
+		}
+
+		if len(curr.children[index].keys) == tree.maxEntries() {
+			tree.splitChild(curr, index)
+
+			switch tree.comparator(key, curr.keys[index]) {
+			case comparison.Equal:
+				curr.values[index] = value
+				return
+			case comparison.FirstBigger:
+				index++
+			}
+		}
+
+		curr = curr.children[index]
+	}
+}
+
+func (tree *Tree[K, V]) mergeChildren(parent *node[K, V], index int) {
+	left, right := parent.children[index], parent.children[index+1]
+
+	key, value := parent.removeEntry(index)
+	parent.removeChild(index + 1)
+
+	left.keys = append(append(left.keys, key), right.keys...)
+	left.values = append(append(left.values, value), right.values...)
+	left.children = append(left.children, right.children...)
+}
+
+func (tree *Tree[K, V]) borrowFromLeft(parent *node[K, V], index int) {
+	child, sibling := parent.children[index], parent.children[index-1]
+
+	lastIndex := len(sibling.keys) - 1
+	key, value := sibling.removeEntry(lastIndex)
+
+	child.insertEntry(0, parent.keys[index-1], parent.values[index-1])
+	parent.keys[index-1], parent.values[index-1] = key, value
+
+	if !sibling.leaf() {
+		child.insertChild(0, sibling.removeChild(lastIndex+1))
+	}
+}
+
+func (tree *Tree[K, V]) borrowFromRight(parent *node[K, V], index int) {
+	child, sibling := parent.children[index], parent.children[index+1]
+
+	key, value := sibling.removeEntry(0)
+
+	child.insertEntry(len(child.keys), parent.keys[index], parent.values[index])
+	parent.keys[index], parent.values[index] = key, value
+
+	if !sibling.leaf() {
+		child.insertChild(len(child.children), sibling.removeChild(0))
+	}
+}
+
+// ensureChildFilled makes sure that the child at the specified index
+// has at least degree entries, so an entry can be removed from it.
+// Returns the index of the child that now covers the same keys.
+func (tree *Tree[K, V]) ensureChildFilled(parent *node[K, V], index int) int {
+	if len(parent.children[index].keys) >= tree.degree {
+		return index
+	}
+
+	switch {
+	case index > 0 && len(parent.children[index-1].keys) >= tree.degree:
+		tree.borrowFromLeft(parent, index)
+	case index < len(parent.keys) && len(parent.children[index+1].keys) >= tree.degree:
+		tree.borrowFromRight(parent, index)
+	case index < len(parent.keys):
+		tree.mergeChildren(parent, index)
+	default:
+		tree.mergeChildren(parent, index-1)
+		index--
+	}
+
+	return index
+}
+
+func (tree *Tree[K, V]) remove(curr *node[K, V], key K) bool {
+	for {
+		index, found := tree.search(curr, key)
+
+		if curr.leaf() {
+			if !found {
+				return false
+			}
+
+			curr.removeEntry(index)
+			return true
+		}
+
+		if !found {
+			index = tree.ensureChildFilled(curr, index)
+			curr = curr.children[index]
+			continue
+		}
+
+		switch left, right := curr.children[index], curr.children[index+1]; {
+		case len(left.keys) >= tree.degree:
+			predecessor := left
+			for !predecessor.leaf() {
+				predecessor = predecessor.children[len(predecessor.children)-1]
+			}
+
+			lastIndex := len(predecessor.keys) - 1
+			curr.keys[index], curr.values[index] = predecessor.keys[lastIndex], predecessor.values[lastIndex]
+
+			key = curr.keys[index]
+			curr = left
+		case len(right.keys) >= tree.degree:
+			successor := right
+			for !successor.leaf() {
+				successor = successor.children[0]
+			}
+
+			curr.keys[index], curr.values[index] = successor.keys[0], successor.values[0]
+
+			key = curr.keys[index]
+			curr = right
+		default:
+			tree.mergeChildren(curr, index)
+			curr = left
+		}
+	}
+}
+
+// Remove removes the entry with the specified key.
+// Does nothing if the key is not present.
+func (tree *Tree[K, V]) Remove(key K) {
+	if tree.root == nil {
+		return
+	}
+
+	if tree.remove(tree.root, key) {
+		tree.entries--
+	}
+
+	if len(tree.root.keys) == 0 {
+		if tree.root.leaf() {
+			tree.root = nil
+		} else {
+			tree.root = tree.root.children[0]
+		}
+	}
+}
+
+// Clear removes all entries from the Tree.
+func (tree *Tree[K, V]) Clear() {
+	tree.root = nil
+	tree.entries = 0
+}
+
+// Clone returns a copy of the Tree.
+func (tree *Tree[K, V]) Clone() maps.Map[K, V] {
+	cloned := &Tree[K, V]{
+		entries:    tree.entries,
+		degree:     tree.degree,
+		comparator: tree.comparator,
+	}
+
+	if tree.root != nil {
+		cloned.root = tree.root.clone()
+	}
+
+	return cloned
+}
+
+// Iterator returns an iter.Iterator over the Tree.
+func (tree *Tree[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return tree.MapIterator()
+}
+
+// MapIterator returns an iterator over the Tree
+// in the order of the keys.
+func (tree *Tree[K, V]) MapIterator() maps.Iterator[K, V] {
+	it := &Iterator[K, V]{tree: tree}
+	it.seekFirst()
+
+	return it
+}
+
+// Stream2 streams over the entries in the Tree
+// in the order of the keys.
+func (tree *Tree[K, V]) Stream2(yield func(K, V) bool) {
+	tree.stream(tree.root, yield)
+}
+
+func (tree *Tree[K, V]) stream(curr *node[K, V], yield func(K, V) bool) bool {
+	if curr == nil {
+		return true
+	}
+
+	for i := range curr.keys {
+		if !curr.leaf() && !tree.stream(curr.children[i], yield) {
+			return false
+		}
+
+		if !yield(curr.keys[i], curr.values[i]) {
+			return false
+		}
+	}
+
+	if !curr.leaf() {
+		return tree.stream(curr.children[len(curr.children)-1], yield)
+	}
+
+	return true
+}
+
+// Keys streams the keys of the Tree.
+func (tree *Tree[K, V]) Keys(yield func(K) bool) {
+	tree.Stream2(func(key K, _ V) bool { return yield(key) })
+}
+
+// Values streams the values of the Tree.
+func (tree *Tree[K, V]) Values(yield func(V) bool) {
+	tree.Stream2(func(_ K, value V) bool { return yield(value) })
+}
+
+// Range returns a stream over the entries
+// with keys in the range [from, to).
+func (tree *Tree[K, V]) Range(from, to K) func(yield func(K, V) bool) {
+	return func(yield func(K, V) bool) {
+		it := &Iterator[K, V]{tree: tree}
+		it.seek(from, true)
+
+		for ; it.Valid(); it.Move() {
+			key := it.Key()
+			if tree.comparator(key, to) != comparison.FirstSmaller {
+				return
+			}
+
+			if !yield(key, it.Value()) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package btree
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestSetRemove(t *testing.T) {
+	tree := NewWithDegree[int, int](2)
+	for i := range 100 {
+		tree.Set(i, i*i)
+	}
+	for i := 0; i < 100; i += 2 {
+		tree.Remove(i)
+	}
+
+	if tree.Size() != 50 {
+		t.Errorf("Size() = %d", tree.Size())
+	}
+
+	prev := -1
+	for key, value := range tree.Stream2 {
+		if key <= prev || key%2 == 0 || value != key*key {
+			t.Fatalf("unexpected entry (%d, %d)", key, value)
+		}
+		prev = key
+	}
+}
+
+func TestRange(t *testing.T) {
+	tree := New[int, string]()
+	for i := range 1000 {
+		tree.Set(i, "")
+	}
+
+	count := 0
+	for key := range tree.Range(250, 500) {
+		if key < 250 || key >= 500 {
+			t.Fatalf("key %d out of range", key)
+		}
+		count++
+	}
+
+	if count != 250 {
+		t.Errorf("Range(250, 500) yielded %d entries", count)
+	}
+}
+
+func checkNode(t *testing.T, tree *Tree[int, int], n *node[int, int], depth int, leafDepth *int) int {
+	t.Helper()
+
+	if n != tree.root && len(n.keys) < tree.degree-1 {
+		t.Fatalf("node has %d entries, fewer than %d", len(n.keys), tree.degree-1)
+	}
+	if len(n.keys) > tree.maxEntries() {
+		t.Fatalf("node has %d entries, more than %d", len(n.keys), tree.maxEntries())
+	}
+	if len(n.values) != len(n.keys) {
+		t.Fatalf("node has %d keys and %d values", len(n.keys), len(n.values))
+	}
+	if !slices.IsSorted(n.keys) {
+		t.Fatalf("node keys %v aren't sorted", n.keys)
+	}
+
+	if n.leaf() {
+		if *leafDepth == -1 {
+			*leafDepth = depth
+		} else if *leafDepth != depth {
+			t.Fatalf("leaves are at depths %d and %d", *leafDepth, depth)
+		}
+
+		return len(n.keys)
+	}
+
+	if len(n.children) != len(n.keys)+1 {
+		t.Fatalf("node has %d entries and %d children", len(n.keys), len(n.children))
+	}
+
+	entries := len(n.keys)
+	for _, child := range n.children {
+		entries += checkNode(t, tree, child, depth+1, leafDepth)
+	}
+
+	return entries
+}
+
+func checkTree(t *testing.T, tree *Tree[int, int], expected map[int]int) {
+	t.Helper()
+
+	entries := 0
+	if tree.root != nil {
+		leafDepth := -1
+		entries = checkNode(t, tree, tree.root, 0, &leafDepth)
+	}
+
+	if entries != len(expected) || tree.Size() != len(expected) {
+		t.Fatalf("tree has %d entries and size %d instead of %d", entries, tree.Size(), len(expected))
+	}
+
+	prev, count := -1, 0
+	for key, value := range tree.Stream2 {
+		if key <= prev {
+			t.Fatalf("key %d after %d", key, prev)
+		}
+		if expectedValue, ok := expected[key]; !ok || value != expectedValue {
+			t.Fatalf("unexpected entry (%d, %d)", key, value)
+		}
+		prev = key
+		count++
+	}
+
+	if count != len(expected) {
+		t.Fatalf("streamed %d entries instead of %d", count, len(expected))
+	}
+}
+
+func TestRandomEdits(t *testing.T) {
+	for degree := 2; degree <= 5; degree++ {
+		random := rand.New(rand.NewSource(int64(degree)))
+
+		tree := NewWithDegree[int, int](degree)
+		expected := make(map[int]int)
+
+		for i := range 5000 {
+			key := random.Intn(300)
+
+			if random.Intn(2) == 0 {
+				tree.Remove(key)
+				delete(expected, key)
+			} else {
+				tree.Set(key, i)
+				expected[key] = i
+			}
+
+			value, ok := tree.TryGet(key)
+			if expectedValue, expectedOk := expected[key]; ok != expectedOk || value != expectedValue {
+				t.Fatalf("degree %d: TryGet(%d) = %d, %t", degree, key, value, ok)
+			}
+
+			if i%50 == 0 {
+				checkTree(t, tree, expected)
+			}
+		}
+
+		checkTree(t, tree, expected)
+
+		for key := range expected {
+			tree.Remove(key)
+			delete(expected, key)
+		}
+
+		checkTree(t, tree, expected)
+	}
+}
+
+func TestClone(t *testing.T) {
+	tree := NewWithDegree[int, int](3)
+	expected := make(map[int]int)
+	for i := range 200 {
+		tree.Set(i, i)
+		expected[i] = i
+	}
+
+	cloned := tree.Clone().(*Tree[int, int])
+	checkTree(t, cloned, expected)
+
+	clonedExpected := make(map[int]int)
+	for i := range 200 {
+		if i%3 != 0 {
+			cloned.Remove(i)
+		} else {
+			cloned.Set(i, -i)
+			clonedExpected[i] = -i
+		}
+	}
+	cloned.Set(500, 500)
+	clonedExpected[500] = 500
+
+	checkTree(t, tree, expected)
+	checkTree(t, cloned, clonedExpected)
+}
+
+func TestIteratorRemove(t *testing.T) {
+	tree := NewWithDegree[int, int](2)
+	expected := make(map[int]int)
+	for i := range 100 {
+		tree.Set(i, i)
+		expected[i] = i
+	}
+
+	it := tree.MapIterator()
+	for it.Valid() {
+		if key := it.Key(); key%3 == 0 {
+			it.Remove()
+			delete(expected, key)
+		} else {
+			it.Move()
+		}
+	}
+
+	checkTree(t, tree, expected)
+
+	it = tree.MapIterator()
+	for it.Valid() {
+		it.Remove()
+	}
+
+	checkTree(t, tree, map[int]int{})
+}
+
+func TestInvalidDegree(t *testing.T) {
+	for _, degree := range []int{-1, 0, 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewWithDegree(%d) didn't panic", degree)
+				}
+			}()
+
+			NewWithDegree[int, int](degree)
+		}()
+	}
+}
@@ -0,0 +1,292 @@
+package cache
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/linkmap"
+	"sync"
+	"time"
+)
+
+// Loader is a function that computes
+// the value associated with the key.
+type Loader[K, V any] func(key K) (V, error)
+
+type entry[V any] struct {
+	value    V
+	loadedAt time.Time
+}
+
+type call[V any] struct {
+	done chan struct{}
+
+	value V
+	err   error
+}
+
+// Cache is a loading cache built on a linkmap.Wrapper.
+// Missing values are computed by the Loader and stored.
+//
+// Concurrent lookups of the same missing key share a single load.
+// Cache is safe for concurrent use, but it should be
+// configured before it is shared between goroutines.
+type Cache[K comparable, V any] struct {
+	mutex sync.Mutex
+
+	entries *linkmap.Wrapper[K, entry[V]]
+	calls   map[K]*call[V]
+
+	loader       Loader[K, V]
+	refreshAfter time.Duration
+	clock        linkmap.Clock
+
+	stats Stats
+}
+
+// New creates an empty Cache with the given Loader
+// that holds at most capacity entries and evicts them in the given order.
+// Non-positive capacity means that the Cache is unbounded.
+func New[K comparable, V any](loader Loader[K, V], capacity int, order linkmap.Order) *Cache[K, V] {
+	cache := &Cache[K, V]{
+		entries: linkmap.NewHashmap[K, entry[V]](capacity, order),
+		calls:   make(map[K]*call[V]),
+
+		loader: loader,
+		clock:  linkmap.SystemClock,
+	}
+
+	cache.entries.OnEvict(func(K, entry[V], linkmap.EvictionReason) {
+		cache.stats.Evictions++
+	})
+
+	return cache
+}
+
+// SetExpireAfterWrite sets the duration after which
+// entries are removed from the Cache.
+// Non-positive duration means that entries never expire.
+func (cache *Cache[K, V]) SetExpireAfterWrite(ttl time.Duration) {
+	cache.entries.SetDefaultTTL(ttl)
+}
+
+// SetRefreshAfterWrite sets the duration after which
+// entries are reloaded in the background on the next lookup.
+// The old value is returned until the reload finishes,
+// and it is kept if the reload fails.
+// Non-positive duration means that entries are never refreshed.
+func (cache *Cache[K, V]) SetRefreshAfterWrite(duration time.Duration) {
+	cache.refreshAfter = duration
+}
+
+// SetClock sets the Clock used for expiry and refresh.
+// By default, linkmap.SystemClock is used.
+func (cache *Cache[K, V]) SetClock(clock linkmap.Clock) {
+	cache.clock = clock
+	cache.entries.SetClock(clock)
+}
+
+// StartExpiry starts a goroutine that removes expired entries
+// at the given interval.
+//
+// Returns a function that stops the goroutine.
+func (cache *Cache[K, V]) StartExpiry(interval time.Duration) (stop func()) {
+	return cache.entries.StartExpiry(interval, &cache.mutex)
+}
+
+func (cache *Cache[K, V]) startLoad(key K) (*call[V], bool) {
+	if c, ok := cache.calls[key]; ok {
+		return c, false
+	}
+
+	c := &call[V]{done: make(chan struct{})}
+	cache.calls[key] = c
+
+	return c, true
+}
+
+func (cache *Cache[K, V]) callLoader(key K, c *call[V]) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero V
+			c.value, c.err = zero, LoaderPanicError{r}
+		}
+	}()
+
+	c.value, c.err = cache.loader(key)
+}
+
+func (cache *Cache[K, V]) load(key K, c *call[V]) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+
+		cache.mutex.Lock()
+		cache.stats.Loads++
+		cache.stats.TotalLoadTime += elapsed
+		if c.err != nil {
+			cache.stats.LoadFailures++
+		}
+
+		if cache.calls[key] == c {
+			delete(cache.calls, key)
+
+			if c.err == nil {
+				cache.entries.Set(key, entry[V]{c.value, cache.clock.Now()})
+			}
+		}
+		cache.mutex.Unlock()
+
+		close(c.done)
+	}()
+
+	cache.callLoader(key, c)
+}
+
+func (cache *Cache[K, V]) lookup(key K) (*call[V], V, bool) {
+	if e, ok := cache.entries.TryGet(key); ok {
+		cache.stats.Hits++
+
+		if cache.refreshAfter > 0 && !cache.clock.Now().Before(e.loadedAt.Add(cache.refreshAfter)) {
+			if c, started := cache.startLoad(key); started {
+				go cache.load(key, c)
+			}
+		}
+
+		return nil, e.value, true
+	}
+
+	cache.stats.Misses++
+
+	c, started := cache.startLoad(key)
+	if started {
+		go cache.load(key, c)
+	}
+
+	var zero V
+	return c, zero, false
+}
+
+// Get returns the value associated with the given key.
+// If the key is not present, the value is loaded,
+// stored and returned.
+//
+// If the Loader returns an error, it is returned
+// and nothing is stored. If the Loader panics,
+// a LoaderPanicError is returned instead.
+func (cache *Cache[K, V]) Get(key K) (V, error) {
+	cache.mutex.Lock()
+	c, value, ok := cache.lookup(key)
+	cache.mutex.Unlock()
+
+	if ok {
+		return value, nil
+	}
+
+	<-c.done
+	return c.value, c.err
+}
+
+// GetAll returns the values associated with the given keys.
+// Missing values are loaded concurrently.
+//
+// If any of the loads fails, the error of the
+// first failed key in the given order is returned.
+func (cache *Cache[K, V]) GetAll(keys ...K) (map[K]V, error) {
+	values := make(map[K]V, len(keys))
+	pending := make(map[K]*call[V])
+	var pendingKeys []K
+
+	cache.mutex.Lock()
+	for _, key := range keys {
+		if _, ok := values[key]; ok {
+			continue
+		}
+		if _, ok := pending[key]; ok {
+			continue
+		}
+
+		c, value, ok := cache.lookup(key)
+		if ok {
+			values[key] = value
+		} else {
+			pending[key] = c
+			pendingKeys = append(pendingKeys, key)
+		}
+	}
+	cache.mutex.Unlock()
+
+	var err error
+	for _, key := range pendingKeys {
+		c := pending[key]
+		<-c.done
+
+		if c.err != nil {
+			if err == nil {
+				err = c.err
+			}
+			continue
+		}
+
+		values[key] = c.value
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// TryGet returns the value associated with the given key
+// if it is present, without loading it.
+func (cache *Cache[K, V]) TryGet(key K) (V, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	e, ok := cache.entries.TryGet(key)
+	return e.value, ok
+}
+
+// Set stores the value associated with the given key.
+// Loads of the key that are in progress are discarded.
+func (cache *Cache[K, V]) Set(key K, value V) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	delete(cache.calls, key)
+	cache.entries.Set(key, entry[V]{value, cache.clock.Now()})
+}
+
+// Invalidate removes the entry with the given key.
+// Loads of the key that are in progress are discarded.
+func (cache *Cache[K, V]) Invalidate(key K) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	delete(cache.calls, key)
+	cache.entries.Remove(key)
+}
+
+// InvalidateAll removes all entries.
+// Loads that are in progress are discarded.
+func (cache *Cache[K, V]) InvalidateAll() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	clear(cache.calls)
+	cache.entries.Clear()
+}
+
+// Size returns the number of entries in the Cache.
+func (cache *Cache[K, V]) Size() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.entries.Size()
+}
+
+// Stats returns a snapshot of the statistics of the Cache.
+func (cache *Cache[K, V]) Stats() Stats {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	return cache.stats
+}
@@ -0,0 +1,250 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/clocktest"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/linkmap"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition was not met in time")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestSingleFlight(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+
+	cache := New(func(key string) (int, error) {
+		loads.Add(1)
+		<-release
+		return len(key), nil
+	}, 0, linkmap.LRU)
+
+	const callers = 10
+
+	var wg sync.WaitGroup
+	results := make([]int, callers)
+	for i := range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = cache.Get("key")
+		}()
+	}
+
+	waitFor(t, func() bool { return cache.Stats().Misses == callers })
+	close(release)
+	wg.Wait()
+
+	if loads.Load() != 1 {
+		t.Errorf("loader was called %d times", loads.Load())
+	}
+
+	for i, result := range results {
+		if result != 3 {
+			t.Errorf("caller %d got %d", i, result)
+		}
+	}
+}
+
+func TestRefreshAfterWrite(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	var version atomic.Int32
+	fail := atomic.Bool{}
+	cache := New(func(key string) (string, error) {
+		if fail.Load() {
+			return "", errors.New("refresh failed")
+		}
+
+		return key + strconv.Itoa(int(version.Add(1))), nil
+	}, 0, linkmap.FIFO)
+	cache.SetClock(clock)
+	cache.SetRefreshAfterWrite(time.Minute)
+
+	if value, _ := cache.Get("a"); value != "a1" {
+		t.Fatalf("first load returned %q", value)
+	}
+
+	clock.Advance(2 * time.Minute)
+
+	if value, _ := cache.Get("a"); value != "a1" {
+		t.Errorf("stale value was not returned during refresh, got %q", value)
+	}
+
+	waitFor(t, func() bool {
+		value, _ := cache.TryGet("a")
+		return value == "a2"
+	})
+
+	fail.Store(true)
+	clock.Advance(2 * time.Minute)
+
+	cache.Get("a")
+	waitFor(t, func() bool { return cache.Stats().LoadFailures == 1 })
+
+	if value, ok := cache.TryGet("a"); !ok || value != "a2" {
+		t.Errorf("failed refresh replaced the value with %q, %v", value, ok)
+	}
+}
+
+func TestGetAll(t *testing.T) {
+	var loads atomic.Int32
+	cache := New(func(key int) (int, error) {
+		loads.Add(1)
+		if key < 0 {
+			return 0, errors.New("negative key")
+		}
+
+		return key * key, nil
+	}, 0, linkmap.FIFO)
+
+	cache.Set(2, 40)
+
+	values, err := cache.GetAll(1, 2, 3, 1, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(values) != 3 || values[1] != 1 || values[2] != 40 || values[3] != 9 {
+		t.Errorf("GetAll() = %v", values)
+	}
+
+	if loads.Load() != 2 {
+		t.Errorf("loader was called %d times", loads.Load())
+	}
+
+	if values, err := cache.GetAll(1, -1); err == nil || values != nil {
+		t.Errorf("GetAll() with failing key = %v, %v", values, err)
+	}
+}
+
+func TestGetAllErrorOrder(t *testing.T) {
+	cache := New(func(key int) (int, error) {
+		if key < 0 {
+			return 0, fmt.Errorf("key %d", key)
+		}
+
+		return key, nil
+	}, 0, linkmap.FIFO)
+
+	for range 20 {
+		if _, err := cache.GetAll(5, -3, -1, 4, -2, -4); err == nil || err.Error() != "key -3" {
+			t.Fatalf("GetAll() returned %v", err)
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	cache := New(func(key int) (int, error) {
+		if key == 0 {
+			return 0, errors.New("zero key")
+		}
+
+		return key, nil
+	}, 2, linkmap.FIFO)
+
+	cache.Get(1)
+	cache.Get(1)
+	cache.Get(2)
+	cache.Get(3)
+	cache.Get(0)
+
+	stats := cache.Stats()
+	if stats.Hits != 1 || stats.Misses != 4 || stats.Requests() != 5 {
+		t.Errorf("hits = %d, misses = %d", stats.Hits, stats.Misses)
+	}
+
+	if stats.Loads != 4 || stats.LoadFailures != 1 {
+		t.Errorf("loads = %d, failures = %d", stats.Loads, stats.LoadFailures)
+	}
+
+	if stats.Evictions != 1 || cache.Size() != 2 {
+		t.Errorf("evictions = %d, size = %d", stats.Evictions, cache.Size())
+	}
+
+	if stats.HitRate() != 0.2 {
+		t.Errorf("hit rate = %f", stats.HitRate())
+	}
+}
+
+func TestLoaderError(t *testing.T) {
+	loaderErr := errors.New("unavailable")
+
+	var loads atomic.Int32
+	cache := New(func(key string) (string, error) {
+		if loads.Add(1) == 1 {
+			return "", loaderErr
+		}
+
+		return key, nil
+	}, 0, linkmap.FIFO)
+
+	if _, err := cache.Get("a"); !errors.Is(err, loaderErr) {
+		t.Fatalf("first Get() error = %v", err)
+	}
+
+	if _, ok := cache.TryGet("a"); ok {
+		t.Error("failed load stored a value")
+	}
+
+	if value, err := cache.Get("a"); err != nil || value != "a" {
+		t.Errorf("second Get() = %q, %v", value, err)
+	}
+}
+
+func TestLoaderPanic(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+
+	cache := New(func(key string) (string, error) {
+		if loads.Add(1) == 1 {
+			<-release
+			panic("broken loader")
+		}
+
+		return key, nil
+	}, 0, linkmap.FIFO)
+	cache.SetRefreshAfterWrite(time.Nanosecond)
+
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := cache.Get("a")
+			errs <- err
+		}()
+	}
+
+	waitFor(t, func() bool { return cache.Stats().Misses == 2 })
+	close(release)
+
+	for range 2 {
+		var panicErr LoaderPanicError
+		if err := <-errs; !errors.As(err, &panicErr) || panicErr.Value != "broken loader" {
+			t.Errorf("Get() error = %v", err)
+		}
+	}
+
+	if value, err := cache.Get("a"); err != nil || value != "a" {
+		t.Errorf("Get() after panic = %q, %v", value, err)
+	}
+
+	loads.Store(0)
+	cache.Get("a")
+	waitFor(t, func() bool { return cache.Stats().LoadFailures == 2 })
+}
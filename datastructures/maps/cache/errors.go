@@ -0,0 +1,14 @@
+package cache
+
+import "fmt"
+
+// LoaderPanicError is an error that is returned
+// when the Loader panics instead of returning.
+type LoaderPanicError struct {
+	Value any // the value that the Loader panicked with
+}
+
+// Error returns the error message.
+func (err LoaderPanicError) Error() string {
+	return fmt.Sprintf("loader panicked: %v", err.Value)
+}
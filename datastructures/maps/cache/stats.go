@@ -0,0 +1,39 @@
+package cache
+
+import "time"
+
+// Stats holds the statistics of a Cache.
+type Stats struct {
+	Hits      uint64 // number of lookups that found a value
+	Misses    uint64 // number of lookups that had to load a value
+	Evictions uint64 // number of entries evicted because of capacity or expiry
+
+	Loads         uint64        // number of finished loads
+	LoadFailures  uint64        // number of loads that returned an error
+	TotalLoadTime time.Duration // total time spent in loads
+}
+
+// Requests returns the total number of lookups.
+func (stats Stats) Requests() uint64 {
+	return stats.Hits + stats.Misses
+}
+
+// HitRate returns the ratio of lookups that found a value.
+// Returns 1 if there were no lookups.
+func (stats Stats) HitRate() float64 {
+	if stats.Requests() == 0 {
+		return 1
+	}
+
+	return float64(stats.Hits) / float64(stats.Requests())
+}
+
+// AverageLoadTime returns the average time spent in a load.
+// Returns 0 if there were no loads.
+func (stats Stats) AverageLoadTime() time.Duration {
+	if stats.Loads == 0 {
+		return 0
+	}
+
+	return stats.TotalLoadTime / time.Duration(stats.Loads)
+}
@@ -12,3 +12,14 @@ type MissingKeyError[K any] struct {
 func (err MissingKeyError[K]) Error() string {
 	return fmt.Sprintf("key %v is missing from map", err.Key)
 }
+
+// KeyOutOfRangeError is an error that is panicked when trying
+// to add a key that is outside the range of a map view.
+type KeyOutOfRangeError[K any] struct {
+	Key K // the key that was out of range
+}
+
+// Error returns the error message.
+func (err KeyOutOfRangeError[K]) Error() string {
+	return fmt.Sprintf("key %v is out of range of map view", err.Key)
+}
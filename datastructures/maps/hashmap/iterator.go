@@ -49,4 +49,5 @@ func (it *Iterator[K, V]) SetValue(value V) {
 // The iterator will point to the next entry afterward.
 func (it *Iterator[K, V]) Remove() {
 	it.m.Remove(it.Key())
+	it.index++
 }
@@ -0,0 +1,33 @@
+package hashmap
+
+import "testing"
+
+func TestIteratorRemove(t *testing.T) {
+	m := New[int, int]()
+	for i := range 10 {
+		m.Set(i, i)
+	}
+
+	visited := 0
+	for it := m.MapIterator(); it.Valid(); {
+		if visited++; visited > 10 {
+			t.Fatal("iterator didn't move after Remove()")
+		}
+
+		if it.Key()%2 == 0 {
+			it.Remove()
+		} else {
+			it.Move()
+		}
+	}
+
+	if m.Size() != 5 {
+		t.Errorf("Size() = %d", m.Size())
+	}
+
+	for key := range m.Keys {
+		if key%2 == 0 {
+			t.Errorf("key %d was not removed", key)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 )
 
 // Map is a hash map with builtin map as a base.
+// It is marshaled to and from JSON the same way as the builtin map.
 type Map[K comparable, V any] map[K]V
 
 // New creates an empty Map.
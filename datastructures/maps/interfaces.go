@@ -70,3 +70,88 @@ type Map[K, V any] interface {
 	// Values streams the values.
 	Values(yield func(V) bool)
 }
+
+// Peeker is a Map whose values can be looked up
+// without changing the order of its entries.
+type Peeker[K, V any] interface {
+	Map[K, V]
+
+	// Peek returns the value associated with the given key
+	// like TryGet, but it doesn't mark the entry as used.
+	Peek(key K) (V, bool)
+}
+
+// NavigableMap is a Map whose entries are ordered by their keys
+// and that can be queried for the closest matches of a key.
+type NavigableMap[K, V any] interface {
+	Map[K, V]
+
+	// Floor returns the entry with the greatest key
+	// less than or equal to the given key.
+	// If there is no such entry, it returns false.
+	Floor(key K) (misc.Pair[K, V], bool)
+	// Ceiling returns the entry with the smallest key
+	// greater than or equal to the given key.
+	// If there is no such entry, it returns false.
+	Ceiling(key K) (misc.Pair[K, V], bool)
+	// Lower returns the entry with the greatest key
+	// strictly less than the given key.
+	// If there is no such entry, it returns false.
+	Lower(key K) (misc.Pair[K, V], bool)
+	// Higher returns the entry with the smallest key
+	// strictly greater than the given key.
+	// If there is no such entry, it returns false.
+	Higher(key K) (misc.Pair[K, V], bool)
+
+	// First returns the entry with the smallest key.
+	// If the map is empty, it returns false.
+	First() (misc.Pair[K, V], bool)
+	// Last returns the entry with the greatest key.
+	// If the map is empty, it returns false.
+	Last() (misc.Pair[K, V], bool)
+
+	// PollFirst removes and returns the entry with the smallest key.
+	// If the map is empty, it returns false.
+	PollFirst() (misc.Pair[K, V], bool)
+	// PollLast removes and returns the entry with the greatest key.
+	// If the map is empty, it returns false.
+	PollLast() (misc.Pair[K, V], bool)
+}
+
+// Multimap is a map that can associate
+// multiple values with the same key.
+type Multimap[K, V any] interface {
+	// KeyCount returns the number of distinct keys.
+	KeyCount() int
+	// ValueCount returns the number of key-value pairs.
+	ValueCount() int
+
+	// Contains returns true if at least one value
+	// is associated with the given key.
+	Contains(key K) bool
+	// ContainsEntry returns true if the given value
+	// is associated with the given key.
+	ContainsEntry(key K, value V) bool
+	// GetAll returns all values associated with the given key.
+	// If the key is not present, it returns an empty slice.
+	GetAll(key K) []V
+	// Put associates the given value with the given key.
+	Put(key K, value V)
+	// RemoveValue removes the given value from
+	// the values associated with the given key.
+	//
+	// If the value is not present, it does nothing.
+	RemoveValue(key K, value V)
+	// RemoveAll removes all values associated with the given key.
+	RemoveAll(key K)
+
+	// Clear removes all entries.
+	Clear()
+
+	misc.Cloner[Multimap[K, V]]
+
+	// Stream2 streams all key-value pairs.
+	Stream2(yield func(K, V) bool)
+	// Keys streams the distinct keys.
+	Keys(yield func(K) bool)
+}
@@ -0,0 +1,27 @@
+package linkmap
+
+import "time"
+
+// Clock is a source of time used for expiry.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// NewTicker returns a channel that receives the current time
+	// after every interval and a function that stops the ticks.
+	NewTicker(interval time.Duration) (ticks <-chan time.Time, stop func())
+}
+
+type systemClock struct{}
+
+// SystemClock is a Clock that uses the system time.
+var SystemClock Clock = systemClock{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTicker(interval time.Duration) (<-chan time.Time, func()) {
+	ticker := time.NewTicker(interval)
+	return ticker.C, ticker.Stop
+}
@@ -20,7 +20,26 @@ func (it *Iterator[K, V]) Move() {
 		return
 	}
 
-	it.curr = it.curr.next
+	it.curr = it.wrapper.live(it.curr.next)
+}
+
+// MoveBack moves the iterator to the previous element.
+func (it *Iterator[K, V]) MoveBack() {
+	if it.curr == nil {
+		return
+	}
+
+	it.curr = it.wrapper.liveBack(it.curr.prev)
+}
+
+// SeekFirst moves the iterator to the first element.
+func (it *Iterator[K, V]) SeekFirst() {
+	it.curr = it.wrapper.live(it.wrapper.head)
+}
+
+// SeekLast moves the iterator to the last element.
+func (it *Iterator[K, V]) SeekLast() {
+	it.curr = it.wrapper.liveBack(it.wrapper.tail)
 }
 
 // Get returns the current entry as a Pair.
@@ -52,8 +71,8 @@ func (it *Iterator[K, V]) SetValue(value V) {
 // The iterator will be moved to the next element.
 func (it *Iterator[K, V]) Remove() {
 	next := it.curr.next
-	it.wrapper.m.Remove(it.curr.key)
-	it.curr = next
+	it.wrapper.removeNode(it.curr)
+	it.curr = it.wrapper.live(next)
 }
 
 // Node returns the current node.
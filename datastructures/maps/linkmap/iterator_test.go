@@ -0,0 +1,29 @@
+package linkmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/clocktest"
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"testing"
+	"time"
+)
+
+func TestIterator(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	w := NewHashmap[string, int](0, LRU)
+	w.SetClock(clock)
+
+	w.Set("a", 1)
+	w.SetWithTTL("expired", 0, time.Second)
+	w.Set("b", 2)
+	w.Set("c", 3)
+	w.Get("a")
+
+	clock.Advance(time.Minute)
+
+	entries := []misc.Pair[string, int]{misc.MakePair("b", 2), misc.MakePair("c", 3), misc.MakePair("a", 1)}
+	itertest.CheckBidirectional[misc.Pair[string, int]](t, w.MapIterator().(*Iterator[string, int]), entries)
+	itertest.CheckBidirectional[misc.Pair[string, int]](t, NewHashmap[string, int](0, FIFO).MapIterator().(*Iterator[string, int]), nil)
+	itertest.CheckReverseStream2(t, w.ReverseStream2, []string{"b", "c", "a"}, []int{2, 3, 1})
+}
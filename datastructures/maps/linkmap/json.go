@@ -0,0 +1,40 @@
+package linkmap
+
+import (
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/jsonmap"
+)
+
+// MarshalJSON encodes the entries that haven't expired
+// as a JSON object whose members are in the order of the wrapper.
+//
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+func (w *Wrapper[K, V]) MarshalJSON() ([]byte, error) {
+	return jsonmap.Marshal(w.Stream2)
+}
+
+// UnmarshalJSON replaces the entries of the wrapper
+// with the members of the JSON object, keeping their order.
+//
+// Keys must be strings, integers or implement encoding.TextUnmarshaler.
+// Entries get the default time-to-live, and if there are more
+// members than the capacity, the first ones are evicted.
+// The Wrapper must already wrap a map,
+// so it should be created with one of the constructors.
+func (w *Wrapper[K, V]) UnmarshalJSON(data []byte) error {
+	if w.m == nil {
+		return errors.New("linkmap: cannot unmarshal into a wrapper without a map")
+	}
+
+	entries, err := jsonmap.Unmarshal[K, V](data)
+	if err != nil {
+		return err
+	}
+
+	w.Clear()
+	for _, entry := range entries {
+		w.Set(entry.First, entry.Second)
+	}
+
+	return nil
+}
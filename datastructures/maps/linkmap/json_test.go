@@ -0,0 +1,41 @@
+package linkmap
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	w := NewHashmap[netip.Addr, int](0, FIFO)
+	w.Set(netip.MustParseAddr("10.0.0.2"), 2)
+	w.Set(netip.MustParseAddr("10.0.0.1"), 1)
+	w.Set(netip.MustParseAddr("::1"), 3)
+
+	data, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const expected = `{"10.0.0.2":2,"10.0.0.1":1,"::1":3}`
+	if string(data) != expected {
+		t.Errorf("Marshal() = %s, expected %s", data, expected)
+	}
+
+	decoded := NewHashmap[netip.Addr, int](0, FIFO)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	redata, _ := json.Marshal(decoded)
+	if string(redata) != expected {
+		t.Errorf("round trip = %s, expected %s", redata, expected)
+	}
+}
+
+func TestUnmarshalJSONIntoZeroWrapper(t *testing.T) {
+	var w Wrapper[netip.Addr, int]
+	if err := json.Unmarshal([]byte(`{"10.0.0.2":2,"10.0.0.1":1}`), &w); err == nil {
+		t.Error("Unmarshal() into a wrapper without a map succeeded")
+	}
+}
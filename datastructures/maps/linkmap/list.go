@@ -0,0 +1,79 @@
+package linkmap
+
+func (w *Wrapper[K, V]) unlink(node *Node[K, V]) {
+	if node.prev != nil {
+		node.prev.next = node.next
+	} else {
+		w.head = node.next
+	}
+
+	if node.next != nil {
+		node.next.prev = node.prev
+	} else {
+		w.tail = node.prev
+	}
+
+	node.prev = nil
+	node.next = nil
+}
+
+func (w *Wrapper[K, V]) linkAfter(anchor, node *Node[K, V]) {
+	node.prev = anchor
+	if anchor != nil {
+		node.next = anchor.next
+		anchor.next = node
+	} else {
+		node.next = w.head
+		w.head = node
+	}
+
+	if node.next != nil {
+		node.next.prev = node
+	} else {
+		w.tail = node
+	}
+}
+
+func (w *Wrapper[K, V]) attach(node *Node[K, V]) {
+	if w.order != LFU {
+		w.linkAfter(w.tail, node)
+		return
+	}
+
+	node.frequency = 1
+	w.linkAfter(w.frequencyTails[1], node)
+	w.frequencyTails[1] = node
+}
+
+func (w *Wrapper[K, V]) detach(node *Node[K, V]) {
+	if w.order == LFU && w.frequencyTails[node.frequency] == node {
+		if node.prev != nil && node.prev.frequency == node.frequency {
+			w.frequencyTails[node.frequency] = node.prev
+		} else {
+			delete(w.frequencyTails, node.frequency)
+		}
+	}
+
+	w.unlink(node)
+}
+
+func (w *Wrapper[K, V]) touch(node *Node[K, V]) {
+	switch w.order {
+	case LRU:
+		w.unlink(node)
+		w.linkAfter(w.tail, node)
+	case LFU:
+		anchor := w.frequencyTails[node.frequency+1]
+		if anchor == nil {
+			anchor = w.frequencyTails[node.frequency]
+		}
+		if anchor == node {
+			anchor = node.prev
+		}
+
+		w.detach(node)
+		node.frequency++
+		w.linkAfter(anchor, node)
+		w.frequencyTails[node.frequency] = node
+	}
+}
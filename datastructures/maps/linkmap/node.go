@@ -1,5 +1,7 @@
 package linkmap
 
+import "time"
+
 // Node is a entry in the map.
 // It is used to keep track of the order of the elements.
 // It should not be created directly.
@@ -7,6 +9,9 @@ type Node[K, V any] struct {
 	key   K
 	Value V // The Value of the entry stored in the node.
 
+	expiry    time.Time
+	frequency int
+
 	prev, next *Node[K, V]
 }
 
@@ -15,6 +20,18 @@ func (node *Node[K, V]) Key() K {
 	return node.key
 }
 
+// Expiry returns the time at which the entry expires.
+// Zero time is returned if the entry never expires.
+func (node *Node[K, V]) Expiry() time.Time {
+	return node.expiry
+}
+
+// Frequency returns the number of times the entry was accessed.
+// It is tracked only in the LFU order.
+func (node *Node[K, V]) Frequency() int {
+	return node.frequency
+}
+
 // Prev returns the previous node in the map.
 func (node *Node[K, V]) Prev() *Node[K, V] {
 	return node.prev
@@ -26,11 +43,13 @@ func (node *Node[K, V]) Next() *Node[K, V] {
 }
 
 // Clone returns a copy of the node.
-// The clone has the same key and value as the node.
+// The clone has the same key, value, expiry and frequency as the node.
 // The clone does not have any links to other nodes.
 func (node *Node[K, V]) Clone() *Node[K, V] {
 	return &Node[K, V]{
-		key:   node.key,
-		Value: node.Value,
+		key:       node.key,
+		Value:     node.Value,
+		expiry:    node.expiry,
+		frequency: node.frequency,
 	}
 }
@@ -5,104 +5,179 @@ import (
 	"github.com/djordje200179/extendedlibrary/datastructures/maps"
 	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
 	"github.com/djordje200179/extendedlibrary/misc"
+	"sync"
+	"time"
 )
 
 // Order represents the order of the nodes in the map.
-type Order bool
+// When the map is full, the first node in the order is evicted.
+type Order int
 
 const (
-	FIFO Order = false // FIFO represents the first-in-first-out order.
-	LRU        = true  // LRU represents the least-recently-used order.
+	FIFO Order = iota // FIFO represents the first-in-first-out order.
+	LRU               // LRU represents the least-recently-used order.
+	LFU               // LFU represents the least-frequently-used order.
+)
+
+// EvictionReason represents the reason why an entry was evicted.
+type EvictionReason int
+
+const (
+	CapacityExceeded EvictionReason = iota // CapacityExceeded means that the entry was evicted to make room for a new one.
+	Expired                                // Expired means that the time-to-live of the entry has passed.
 )
 
 // Wrapper is a map that keeps track of the order of the nodes.
+//
+// Entries can have a time-to-live, after which they are removed.
+// Expired entries are removed lazily when they are accessed,
+// or eagerly by RemoveExpired and StartExpiry.
 type Wrapper[K, V any] struct {
 	m maps.Map[K, *Node[K, V]]
 
 	head, tail *Node[K, V]
 	order      Order
 
+	frequencyTails map[int]*Node[K, V]
+
 	capacity int
+
+	defaultTTL     time.Duration
+	earliestExpiry time.Time
+	clock          Clock
+
+	onEvict func(key K, value V, reason EvictionReason)
 }
 
-// From returns a new Wrapper that wraps the given map.
-func From[K, V any](m maps.Map[K, *Node[K, V]], capacity int, order Order) *Wrapper[K, V] {
+func newWrapper[K, V any](m maps.Map[K, *Node[K, V]], capacity int, order Order) *Wrapper[K, V] {
 	wrapper := &Wrapper[K, V]{
 		m: m,
 
 		order:    order,
 		capacity: capacity,
+
+		clock: SystemClock,
 	}
 
-	for it := m.Iterator(); it.Valid(); it.Move() {
-		node := it.Get().Second
-		if wrapper.head == nil {
-			wrapper.head = node
-		} else {
-			wrapper.tail = node
-		}
+	if order == LFU {
+		wrapper.frequencyTails = make(map[int]*Node[K, V])
 	}
 
 	return wrapper
 }
 
-// NewHashmap returns a new Wrapper around a empty hashmap.
-func NewHashmap[K comparable, V any](capacity int, order Order) *Wrapper[K, V] {
-	m := hashmap.NewWithCapacity[K, *Node[K, V]](capacity)
-	wrapper := &Wrapper[K, V]{
-		m: m,
+// From returns a new Wrapper that wraps the given map.
+// Nodes are ordered in the iteration order of the map
+// and their access frequencies are reset.
+func From[K, V any](m maps.Map[K, *Node[K, V]], capacity int, order Order) *Wrapper[K, V] {
+	wrapper := newWrapper(m, capacity, order)
 
-		order:    order,
-		capacity: capacity,
+	for node := range m.Values {
+		node.prev = nil
+		node.next = nil
+		wrapper.attach(node)
 	}
 
 	return wrapper
 }
 
-func (w *Wrapper[K, V]) moveToFront(node *Node[K, V]) {
-	if node.prev != nil {
-		node.prev.next = node.next
-	} else {
-		w.head = node.next
+// NewHashmap returns a new Wrapper around a empty hashmap.
+func NewHashmap[K comparable, V any](capacity int, order Order) *Wrapper[K, V] {
+	return newWrapper[K, V](hashmap.NewWithCapacity[K, *Node[K, V]](capacity), capacity, order)
+}
+
+// OnEvict sets the function that is called
+// whenever an entry is evicted from the map.
+// It is not called for entries that are explicitly removed.
+func (w *Wrapper[K, V]) OnEvict(callback func(key K, value V, reason EvictionReason)) {
+	w.onEvict = callback
+}
+
+// SetDefaultTTL sets the time-to-live of entries
+// that are added without an explicit one.
+// Non-positive duration means that entries never expire.
+func (w *Wrapper[K, V]) SetDefaultTTL(ttl time.Duration) {
+	w.defaultTTL = ttl
+}
+
+// SetClock sets the Clock used to check for expiry
+// and to drive StartExpiry. By default, SystemClock is used.
+func (w *Wrapper[K, V]) SetClock(clock Clock) {
+	w.clock = clock
+}
+
+func (w *Wrapper[K, V]) expired(node *Node[K, V]) bool {
+	return !node.expiry.IsZero() && !w.clock.Now().Before(node.expiry)
+}
+
+func (w *Wrapper[K, V]) removeNode(node *Node[K, V]) {
+	w.detach(node)
+	w.m.Remove(node.key)
+}
+
+func (w *Wrapper[K, V]) evict(node *Node[K, V], reason EvictionReason) {
+	w.removeNode(node)
+
+	if w.onEvict != nil {
+		w.onEvict(node.key, node.Value, reason)
 	}
+}
 
-	if node.next != nil {
-		node.next.prev = node.prev
-	} else {
-		w.tail = node.prev
+func (w *Wrapper[K, V]) getNode(key K) *Node[K, V] {
+	node, ok := w.m.TryGet(key)
+	if !ok {
+		return nil
 	}
 
-	if w.head == nil {
-		w.head = node
-	} else {
-		w.tail.next = node
-		node.prev = w.tail
+	if w.expired(node) {
+		w.evict(node, Expired)
+		return nil
+	}
+
+	return node
+}
+
+func (w *Wrapper[K, V]) removeDueExpired() {
+	if !w.earliestExpiry.IsZero() && !w.clock.Now().Before(w.earliestExpiry) {
+		w.RemoveExpired()
 	}
-	w.tail = node
 }
 
 // Size returns the number of entries in the map.
+// Expired entries are removed before they are counted.
 func (w *Wrapper[K, V]) Size() int {
+	w.removeDueExpired()
 	return w.m.Size()
 }
 
 // Contains returns true if the map contains the given key.
 func (w *Wrapper[K, V]) Contains(key K) bool {
-	return w.m.Contains(key)
+	return w.getNode(key) != nil
 }
 
 // TryGet returns the value associated with the given key.
 // If the key is not in the map, the zero value and false is returned.
-// If the order is LRU, the entry is moved to the front.
+// If the order is LRU or LFU, the entry is moved accordingly.
 func (w *Wrapper[K, V]) TryGet(key K) (V, bool) {
-	node, ok := w.m.TryGet(key)
-	if !ok {
+	node := w.getNode(key)
+	if node == nil {
 		var zero V
 		return zero, false
 	}
 
-	if w.order == LRU {
-		w.moveToFront(node)
+	w.touch(node)
+
+	return node.Value, true
+}
+
+// Peek returns the value associated with the given key
+// without moving the entry, regardless of the order.
+// If the key is not in the map, the zero value and false is returned.
+func (w *Wrapper[K, V]) Peek(key K) (V, bool) {
+	node := w.getNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
 	}
 
 	return node.Value, true
@@ -110,68 +185,68 @@ func (w *Wrapper[K, V]) TryGet(key K) (V, bool) {
 
 // Get returns the value associated with the given key.
 // Panics if the key is not in the map.
-// If the order is LRU, the entry is moved to the front.
+// If the order is LRU or LFU, the entry is moved accordingly.
 func (w *Wrapper[K, V]) Get(key K) V {
-	node := w.m.Get(key)
-
-	if w.order == LRU {
-		w.moveToFront(node)
-	}
-
-	return node.Value
+	return *w.GetRef(key)
 }
 
 // GetRef returns a reference to the value associated with the given key.
 // Panics if the key is not in the map.
-// If the order is LRU, the entry is moved to the front.
+// If the order is LRU or LFU, the entry is moved accordingly.
 func (w *Wrapper[K, V]) GetRef(key K) *V {
-	node := w.m.Get(key)
-
-	if w.order == LRU {
-		w.moveToFront(node)
+	node := w.getNode(key)
+	if node == nil {
+		panic(maps.MissingKeyError[K]{Key: key})
 	}
 
+	w.touch(node)
+
 	return &node.Value
 }
 
-// Set sets the value associated with the given key.
-// Entry is moved to the front of the map.
-// If the map is full, the last entry is removed.
+// Set sets the value associated with the given key
+// with the default time-to-live.
+// If the map is full, expired entries are evicted first,
+// and the first entry in the order is evicted only if none expired.
 func (w *Wrapper[K, V]) Set(key K, value V) {
-	node, ok := w.m.TryGet(key)
-	if ok {
-		node.Value = value
+	w.SetWithTTL(key, value, w.defaultTTL)
+}
 
-		if w.order == LRU {
-			w.moveToFront(node)
+// SetWithTTL sets the value associated with the given key
+// that expires after the given duration.
+// Non-positive duration means that the entry never expires.
+// If the map is full, expired entries are evicted first,
+// and the first entry in the order is evicted only if none expired.
+func (w *Wrapper[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expiry time.Time
+	if ttl > 0 {
+		expiry = w.clock.Now().Add(ttl)
+
+		if w.earliestExpiry.IsZero() || expiry.Before(w.earliestExpiry) {
+			w.earliestExpiry = expiry
 		}
-
-		return
 	}
 
-	if w.capacity > 0 && w.m.Size() == w.capacity {
-		firstNode := w.head
+	node := w.getNode(key)
+	if node != nil {
+		node.Value = value
+		node.expiry = expiry
 
-		w.head = firstNode.next
-		if w.head != nil {
-			w.head.prev = nil
-		} else {
-			w.tail = nil
-		}
+		w.touch(node)
 
-		w.m.Remove(firstNode.key)
+		return
 	}
 
-	node = &Node[K, V]{key: key, Value: value}
+	if w.capacity > 0 && w.m.Size() >= w.capacity {
+		w.removeDueExpired()
 
-	if w.head == nil {
-		w.head = node
-	} else {
-		w.tail.next = node
-		node.prev = w.tail
+		if w.m.Size() >= w.capacity {
+			w.evict(w.head, CapacityExceeded)
+		}
 	}
-	w.tail = node
 
+	node = &Node[K, V]{key: key, Value: value, expiry: expiry}
+	w.attach(node)
 	w.m.Set(key, node)
 }
 
@@ -183,19 +258,60 @@ func (w *Wrapper[K, V]) Remove(key K) {
 		return
 	}
 
-	if node.prev != nil {
-		node.prev.next = node.next
-	} else {
-		w.head = node.next
-	}
+	w.removeNode(node)
+}
+
+// RemoveExpired removes all expired entries from the map.
+// Returns the number of removed entries.
+func (w *Wrapper[K, V]) RemoveExpired() int {
+	removed := 0
+	w.earliestExpiry = time.Time{}
+
+	for node := w.head; node != nil; {
+		next := node.next
+
+		switch {
+		case w.expired(node):
+			w.evict(node, Expired)
+			removed++
+		case node.expiry.IsZero():
+		case w.earliestExpiry.IsZero() || node.expiry.Before(w.earliestExpiry):
+			w.earliestExpiry = node.expiry
+		}
 
-	if node.next != nil {
-		node.next.prev = node.prev
-	} else {
-		w.tail = node.prev
+		node = next
 	}
 
-	w.m.Remove(key)
+	return removed
+}
+
+// StartExpiry starts a goroutine that removes expired entries
+// at the given interval of the Clock set by SetClock.
+// The locker is held while the entries are removed,
+// so it should be the same lock that guards other accesses to the map.
+//
+// Returns a function that stops the goroutine.
+func (w *Wrapper[K, V]) StartExpiry(interval time.Duration, locker sync.Locker) (stop func()) {
+	ticks, stopTicks := w.clock.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticks:
+				locker.Lock()
+				w.RemoveExpired()
+				locker.Unlock()
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return sync.OnceFunc(func() {
+		stopTicks()
+		close(done)
+	})
 }
 
 // Clear removes all entries from the map.
@@ -204,39 +320,34 @@ func (w *Wrapper[K, V]) Clear() {
 
 	w.head = nil
 	w.tail = nil
+	w.earliestExpiry = time.Time{}
+
+	if w.order == LFU {
+		clear(w.frequencyTails)
+	}
 }
 
 // Clone returns a copy of the wrapper and
 // of the underlying map.
 func (w *Wrapper[K, V]) Clone() maps.Map[K, V] {
-	clonedMap := w.m.Clone()
-
-	var clonedHead, clonedTail *Node[K, V]
-	for oldIt, newIt := w.m.Iterator(), clonedMap.MapIterator(); oldIt.Valid(); oldIt.Move() {
-		oldNode := oldIt.Get().Second
-
-		newNode := oldNode.Clone()
-		if clonedHead == nil {
-			clonedHead = newNode
-		} else {
-			clonedTail.next = newNode
-			newNode.prev = clonedTail
+	cloned := newWrapper(w.m.Clone(), w.capacity, w.order)
+	cloned.defaultTTL = w.defaultTTL
+	cloned.earliestExpiry = w.earliestExpiry
+	cloned.clock = w.clock
+	cloned.onEvict = w.onEvict
+
+	for node := w.head; node != nil; node = node.next {
+		clonedNode := node.Clone()
+
+		cloned.linkAfter(cloned.tail, clonedNode)
+		if cloned.order == LFU {
+			cloned.frequencyTails[clonedNode.frequency] = clonedNode
 		}
-		clonedTail = newNode
 
-		newIt.SetValue(newNode)
-
-		newIt.Move()
+		cloned.m.Set(clonedNode.key, clonedNode)
 	}
 
-	return &Wrapper[K, V]{
-		m: clonedMap,
-
-		head:     clonedHead,
-		tail:     clonedTail,
-		capacity: w.capacity,
-		order:    w.order,
-	}
+	return cloned
 }
 
 // Iterator returns an iter.Iterator over the entries in the map.
@@ -245,8 +356,25 @@ func (w *Wrapper[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
 }
 
 // MapIterator returns a iterator over the entries in the map.
+// Expired entries are skipped.
 func (w *Wrapper[K, V]) MapIterator() maps.Iterator[K, V] {
-	return &Iterator[K, V]{wrapper: w, curr: w.head}
+	return &Iterator[K, V]{wrapper: w, curr: w.live(w.head)}
+}
+
+func (w *Wrapper[K, V]) live(node *Node[K, V]) *Node[K, V] {
+	for node != nil && w.expired(node) {
+		node = node.next
+	}
+
+	return node
+}
+
+func (w *Wrapper[K, V]) liveBack(node *Node[K, V]) *Node[K, V] {
+	for node != nil && w.expired(node) {
+		node = node.prev
+	}
+
+	return node
 }
 
 // Stream2 streams over the entries in the Map.
@@ -258,6 +386,16 @@ func (w *Wrapper[K, V]) Stream2(yield func(K, V) bool) {
 	}
 }
 
+// ReverseStream2 streams over the entries in the Map in reverse order.
+// Expired entries are skipped.
+func (w *Wrapper[K, V]) ReverseStream2(yield func(K, V) bool) {
+	for node := w.liveBack(w.tail); node != nil; node = w.liveBack(node.prev) {
+		if !yield(node.key, node.Value) {
+			break
+		}
+	}
+}
+
 // Keys streams the keys of the maps.Map.
 func (w *Wrapper[K, V]) Keys(yield func(K) bool) {
 	for it := w.MapIterator(); it.Valid(); it.Move() {
@@ -0,0 +1,193 @@
+package linkmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/clocktest"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestExpiry(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	w := NewHashmap[string, int](0, FIFO)
+	w.SetClock(clock)
+	w.SetDefaultTTL(time.Minute)
+
+	var evicted []string
+	w.OnEvict(func(key string, value int, reason EvictionReason) {
+		if reason != Expired {
+			t.Errorf("unexpected eviction reason %d", reason)
+		}
+		evicted = append(evicted, key)
+	})
+
+	w.Set("a", 1)
+	w.SetWithTTL("b", 2, time.Hour)
+	w.SetWithTTL("c", 3, 0)
+
+	clock.Advance(2 * time.Minute)
+	if w.Contains("a") {
+		t.Error("entry a should have expired")
+	}
+
+	clock.Advance(2 * time.Hour)
+	if removed := w.RemoveExpired(); removed != 1 {
+		t.Errorf("RemoveExpired() = %d", removed)
+	}
+
+	if w.Size() != 1 || len(evicted) != 2 {
+		t.Errorf("Size() = %d, evicted = %v", w.Size(), evicted)
+	}
+}
+
+func TestRemoveExpired(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	w := NewHashmap[int, int](0, FIFO)
+	w.SetClock(clock)
+	for i := range 6 {
+		w.SetWithTTL(i, i, time.Duration(6-i)*time.Minute)
+	}
+	w.Set(6, 6)
+
+	if removed := w.RemoveExpired(); removed != 0 {
+		t.Errorf("RemoveExpired() before any expiry = %d", removed)
+	}
+
+	clock.Advance(2 * time.Minute)
+	if removed := w.RemoveExpired(); removed != 2 {
+		t.Errorf("RemoveExpired() after 2 minutes = %d", removed)
+	}
+
+	if keys := slices.Collect(w.Keys); !slices.Equal(keys, []int{0, 1, 2, 3, 6}) {
+		t.Errorf("remaining keys are %v", keys)
+	}
+
+	clock.Advance(time.Hour)
+	if removed := w.RemoveExpired(); removed != 4 || w.Size() != 1 {
+		t.Errorf("RemoveExpired() after an hour = %d, size is %d", removed, w.Size())
+	}
+}
+
+func TestStartExpiry(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+	var mutex sync.Mutex
+
+	w := NewHashmap[string, int](0, FIFO)
+	w.SetClock(clock)
+	w.SetWithTTL("a", 1, time.Minute)
+	w.Set("b", 2)
+
+	evicted := make(chan string, 1)
+	w.OnEvict(func(key string, value int, reason EvictionReason) {
+		evicted <- key
+	})
+
+	stop := w.StartExpiry(time.Second, &mutex)
+	defer stop()
+
+	clock.Advance(time.Hour)
+
+	select {
+	case key := <-evicted:
+		if key != "a" {
+			t.Errorf("entry %s was evicted", key)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expired entry was not removed")
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if keys := slices.Collect(w.Keys); !slices.Equal(keys, []string{"b"}) {
+		t.Errorf("remaining keys are %v", keys)
+	}
+}
+
+func TestSizeAfterExpiry(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	first := NewHashmap[string, int](0, FIFO)
+	first.SetClock(clock)
+	first.Set("a", 1)
+	first.Set("b", 2)
+	first.SetWithTTL("expired", 3, time.Minute)
+
+	second := NewHashmap[string, int](0, FIFO)
+	second.Set("a", 1)
+	second.Set("b", 2)
+	second.Set("extra", 3)
+
+	clock.Advance(time.Hour)
+
+	if size := first.Size(); size != 2 {
+		t.Errorf("Size() = %d", size)
+	}
+
+	if maps.Equal[string, int](first, second, func(a, b int) bool { return a == b }) {
+		t.Error("maps with different keys are equal")
+	}
+}
+
+func TestLFU(t *testing.T) {
+	w := NewHashmap[int, int](3, LFU)
+
+	var evicted []int
+	w.OnEvict(func(key int, value int, reason EvictionReason) {
+		evicted = append(evicted, key)
+	})
+
+	w.Set(1, 1)
+	w.Set(2, 2)
+	w.Set(3, 3)
+	w.Get(1)
+	w.Get(1)
+	w.Get(3)
+	w.Set(4, 4)
+	w.Set(5, 5)
+
+	if len(evicted) != 2 || evicted[0] != 2 || evicted[1] != 4 {
+		t.Errorf("evicted = %v", evicted)
+	}
+}
+
+func TestCapacityPrefersExpired(t *testing.T) {
+	clock := clocktest.NewManualClock(time.Unix(0, 0))
+
+	w := NewHashmap[string, int](2, LRU)
+	w.SetClock(clock)
+
+	reasons := make(map[string]EvictionReason)
+	w.OnEvict(func(key string, value int, reason EvictionReason) {
+		reasons[key] = reason
+	})
+
+	w.SetWithTTL("a", 1, time.Minute)
+	w.Set("b", 2)
+
+	clock.Advance(2 * time.Minute)
+	w.Set("c", 3)
+
+	if reason, ok := reasons["a"]; !ok || reason != Expired {
+		t.Errorf("entry a was evicted with %v, %d", ok, reason)
+	}
+
+	w.SetWithTTL("d", 4, time.Minute)
+	w.Set("e", 5)
+
+	if reason, ok := reasons["b"]; !ok || reason != CapacityExceeded {
+		t.Errorf("entry b was evicted with %v, %d", ok, reason)
+	}
+
+	w.Get("d")
+	clock.Advance(2 * time.Minute)
+	w.Set("f", 6)
+
+	if reasons["d"] != Expired || !w.Contains("e") || !w.Contains("f") {
+		t.Errorf("reasons = %v, size = %d", reasons, w.Size())
+	}
+}
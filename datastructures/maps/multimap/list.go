@@ -0,0 +1,187 @@
+package multimap
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/array"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+)
+
+// ListMultimap is a maps.Multimap that keeps the values
+// of each key in a list, in the order of insertion.
+// The same value can be associated with a key multiple times.
+type ListMultimap[K, V any] struct {
+	m       maps.Map[K, cols.Collection[V]]
+	equaler hashing.Equaler[V]
+
+	values int
+}
+
+// NewHashListMultimap creates an empty ListMultimap backed by a hashmap.
+func NewHashListMultimap[K, V comparable]() *ListMultimap[K, V] {
+	return FromListMap[K, V](hashmap.New[K, cols.Collection[V]](), hashing.DefaultEqualer[V]())
+}
+
+// NewTreeListMultimap creates an empty ListMultimap backed by a red-black tree.
+func NewTreeListMultimap[K cmp.Ordered, V comparable]() *ListMultimap[K, V] {
+	return FromListMap[K, V](rbt.New[K, cols.Collection[V]](), hashing.DefaultEqualer[V]())
+}
+
+// FromListMap creates a ListMultimap from the specified map
+// of keys to lists of values.
+// Values are compared with the specified hashing.Equaler.
+//
+// The map should not contain empty lists.
+func FromListMap[K, V any](m maps.Map[K, cols.Collection[V]], equaler hashing.Equaler[V]) *ListMultimap[K, V] {
+	mm := &ListMultimap[K, V]{
+		m:       m,
+		equaler: equaler,
+	}
+
+	for list := range m.Values {
+		mm.values += list.Size()
+	}
+
+	return mm
+}
+
+// KeyCount returns the number of distinct keys in the ListMultimap.
+func (mm *ListMultimap[K, V]) KeyCount() int {
+	return mm.m.Size()
+}
+
+// ValueCount returns the number of key-value pairs in the ListMultimap.
+func (mm *ListMultimap[K, V]) ValueCount() int {
+	return mm.values
+}
+
+// Contains returns true if at least one value
+// is associated with the specified key.
+func (mm *ListMultimap[K, V]) Contains(key K) bool {
+	return mm.m.Contains(key)
+}
+
+// ContainsEntry returns true if the specified value
+// is associated with the specified key.
+func (mm *ListMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	list, ok := mm.m.TryGet(key)
+	if !ok {
+		return false
+	}
+
+	for curr := range list.Stream {
+		if mm.equaler(curr, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetAll returns all values associated with the specified key
+// in the order of insertion.
+func (mm *ListMultimap[K, V]) GetAll(key K) []V {
+	list, ok := mm.m.TryGet(key)
+	if !ok {
+		return []V{}
+	}
+
+	values := make([]V, 0, list.Size())
+	for value := range list.Stream {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Put appends the specified value to the values
+// associated with the specified key.
+func (mm *ListMultimap[K, V]) Put(key K, value V) {
+	list, ok := mm.m.TryGet(key)
+	if !ok {
+		list = array.New[V]()
+		mm.m.Set(key, list)
+	}
+
+	list.Append(value)
+	mm.values++
+}
+
+// RemoveValue removes the first occurrence of the specified value
+// from the values associated with the specified key.
+// The key is removed if it has no values left.
+func (mm *ListMultimap[K, V]) RemoveValue(key K, value V) {
+	list, ok := mm.m.TryGet(key)
+	if !ok {
+		return
+	}
+
+	for it := list.CollectionIterator(); it.Valid(); it.Move() {
+		if mm.equaler(it.Get(), value) {
+			it.Remove()
+			mm.values--
+			break
+		}
+	}
+
+	if list.Size() == 0 {
+		mm.m.Remove(key)
+	}
+}
+
+// RemoveAll removes all values associated with the specified key.
+func (mm *ListMultimap[K, V]) RemoveAll(key K) {
+	list, ok := mm.m.TryGet(key)
+	if !ok {
+		return
+	}
+
+	mm.values -= list.Size()
+	mm.m.Remove(key)
+}
+
+// Clear removes all entries from the ListMultimap.
+func (mm *ListMultimap[K, V]) Clear() {
+	mm.m.Clear()
+	mm.values = 0
+}
+
+// Clone returns a copy of the ListMultimap.
+// Lists of values are copied, but values themselves are not.
+func (mm *ListMultimap[K, V]) Clone() maps.Multimap[K, V] {
+	cloned := &ListMultimap[K, V]{
+		m:       mm.m.Clone(),
+		equaler: mm.equaler,
+		values:  mm.values,
+	}
+
+	for it := cloned.m.MapIterator(); it.Valid(); it.Move() {
+		it.SetValue(it.Value().Clone())
+	}
+
+	return cloned
+}
+
+// Stream2 streams all key-value pairs of the ListMultimap.
+func (mm *ListMultimap[K, V]) Stream2(yield func(K, V) bool) {
+	for key, list := range mm.m.Stream2 {
+		for value := range list.Stream {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys streams the distinct keys of the ListMultimap.
+func (mm *ListMultimap[K, V]) Keys(yield func(K) bool) {
+	mm.m.Keys(yield)
+}
+
+// Map returns the underlying map.
+func (mm *ListMultimap[K, V]) Map() maps.Map[K, cols.Collection[V]] {
+	return mm.m
+}
@@ -0,0 +1,77 @@
+package multimap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestListMultimap(t *testing.T) {
+	mm := NewTreeListMultimap[string, int]()
+	mm.Put("b", 1)
+	mm.Put("a", 2)
+	mm.Put("b", 3)
+	mm.Put("b", 1)
+
+	if mm.KeyCount() != 2 || mm.ValueCount() != 4 {
+		t.Fatalf("KeyCount() = %d, ValueCount() = %d", mm.KeyCount(), mm.ValueCount())
+	}
+
+	if values := mm.GetAll("b"); !slices.Equal(values, []int{1, 3, 1}) {
+		t.Errorf("GetAll(b) = %v", values)
+	}
+
+	if values := mm.GetAll("c"); values == nil || len(values) != 0 {
+		t.Errorf("GetAll(c) = %#v", values)
+	}
+
+	if !mm.ContainsEntry("b", 3) || mm.ContainsEntry("a", 3) || mm.ContainsEntry("c", 1) {
+		t.Error("ContainsEntry() is wrong")
+	}
+
+	mm.RemoveValue("b", 1)
+	if values := mm.GetAll("b"); !slices.Equal(values, []int{3, 1}) || mm.ValueCount() != 3 {
+		t.Errorf("GetAll(b) = %v after removing the first occurrence", values)
+	}
+
+	mm.RemoveValue("a", 5)
+	mm.RemoveValue("a", 2)
+	if mm.Contains("a") || mm.KeyCount() != 1 || mm.ValueCount() != 2 {
+		t.Error("key without values was not removed")
+	}
+}
+
+func TestListMultimapClone(t *testing.T) {
+	mm := NewHashListMultimap[int, int]()
+	for i := range 10 {
+		mm.Put(i%3, i)
+	}
+
+	cloned := mm.Clone()
+	cloned.Put(0, 100)
+	cloned.RemoveAll(1)
+
+	if mm.ValueCount() != 10 || !mm.Contains(1) || mm.ContainsEntry(0, 100) {
+		t.Error("modifying the clone changed the original")
+	}
+
+	if cloned.ValueCount() != 8 || cloned.KeyCount() != 2 {
+		t.Errorf("clone has %d keys and %d values", cloned.KeyCount(), cloned.ValueCount())
+	}
+
+	pairs := 0
+	for key, value := range mm.Stream2 {
+		if value%3 != key {
+			t.Errorf("streamed pair (%d, %d)", key, value)
+		}
+		pairs++
+	}
+
+	if pairs != 10 {
+		t.Errorf("streamed %d pairs", pairs)
+	}
+
+	mm.Clear()
+	if mm.KeyCount() != 0 || mm.ValueCount() != 0 || cloned.ValueCount() != 8 {
+		t.Error("Clear() didn't remove only the original entries")
+	}
+}
@@ -0,0 +1,175 @@
+package multimap
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
+	"github.com/djordje200179/extendedlibrary/datastructures/sets"
+	"github.com/djordje200179/extendedlibrary/datastructures/sets/mapset"
+)
+
+// SetMultimap is a maps.Multimap that keeps the values
+// of each key in a set.
+// The same value can be associated with a key only once.
+type SetMultimap[K, V any] struct {
+	m      maps.Map[K, sets.Set[V]]
+	newSet func() sets.Set[V]
+
+	values int
+}
+
+// NewHashSetMultimap creates an empty SetMultimap
+// backed by a hashmap and hash sets.
+func NewHashSetMultimap[K, V comparable]() *SetMultimap[K, V] {
+	return FromSetMap[K, V](hashmap.New[K, sets.Set[V]](), func() sets.Set[V] { return mapset.NewHashSet[V]() })
+}
+
+// NewTreeSetMultimap creates an empty SetMultimap
+// backed by a red-black tree and tree sets.
+func NewTreeSetMultimap[K, V cmp.Ordered]() *SetMultimap[K, V] {
+	return FromSetMap[K, V](rbt.New[K, sets.Set[V]](), func() sets.Set[V] { return mapset.NewTreeSet[V]() })
+}
+
+// FromSetMap creates a SetMultimap from the specified map
+// of keys to sets of values.
+// Sets for new keys are created with the specified function.
+//
+// The map should not contain empty sets.
+func FromSetMap[K, V any](m maps.Map[K, sets.Set[V]], newSet func() sets.Set[V]) *SetMultimap[K, V] {
+	mm := &SetMultimap[K, V]{
+		m:      m,
+		newSet: newSet,
+	}
+
+	for set := range m.Values {
+		mm.values += set.Size()
+	}
+
+	return mm
+}
+
+// KeyCount returns the number of distinct keys in the SetMultimap.
+func (mm *SetMultimap[K, V]) KeyCount() int {
+	return mm.m.Size()
+}
+
+// ValueCount returns the number of key-value pairs in the SetMultimap.
+func (mm *SetMultimap[K, V]) ValueCount() int {
+	return mm.values
+}
+
+// Contains returns true if at least one value
+// is associated with the specified key.
+func (mm *SetMultimap[K, V]) Contains(key K) bool {
+	return mm.m.Contains(key)
+}
+
+// ContainsEntry returns true if the specified value
+// is associated with the specified key.
+func (mm *SetMultimap[K, V]) ContainsEntry(key K, value V) bool {
+	set, ok := mm.m.TryGet(key)
+	return ok && set.Contains(value)
+}
+
+// GetAll returns all values associated with the specified key.
+func (mm *SetMultimap[K, V]) GetAll(key K) []V {
+	set, ok := mm.m.TryGet(key)
+	if !ok {
+		return []V{}
+	}
+
+	values := make([]V, 0, set.Size())
+	for value := range set.Stream {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// Put adds the specified value to the values
+// associated with the specified key.
+// Does nothing if the value is already associated with the key.
+func (mm *SetMultimap[K, V]) Put(key K, value V) {
+	set, ok := mm.m.TryGet(key)
+	if !ok {
+		set = mm.newSet()
+		mm.m.Set(key, set)
+	}
+
+	oldSize := set.Size()
+	set.Add(value)
+	mm.values += set.Size() - oldSize
+}
+
+// RemoveValue removes the specified value from the values
+// associated with the specified key.
+// The key is removed if it has no values left.
+func (mm *SetMultimap[K, V]) RemoveValue(key K, value V) {
+	set, ok := mm.m.TryGet(key)
+	if !ok {
+		return
+	}
+
+	oldSize := set.Size()
+	set.Remove(value)
+	mm.values -= oldSize - set.Size()
+
+	if set.Size() == 0 {
+		mm.m.Remove(key)
+	}
+}
+
+// RemoveAll removes all values associated with the specified key.
+func (mm *SetMultimap[K, V]) RemoveAll(key K) {
+	set, ok := mm.m.TryGet(key)
+	if !ok {
+		return
+	}
+
+	mm.values -= set.Size()
+	mm.m.Remove(key)
+}
+
+// Clear removes all entries from the SetMultimap.
+func (mm *SetMultimap[K, V]) Clear() {
+	mm.m.Clear()
+	mm.values = 0
+}
+
+// Clone returns a copy of the SetMultimap.
+// Sets of values are copied, but values themselves are not.
+func (mm *SetMultimap[K, V]) Clone() maps.Multimap[K, V] {
+	cloned := &SetMultimap[K, V]{
+		m:      mm.m.Clone(),
+		newSet: mm.newSet,
+		values: mm.values,
+	}
+
+	for it := cloned.m.MapIterator(); it.Valid(); it.Move() {
+		it.SetValue(it.Value().Clone())
+	}
+
+	return cloned
+}
+
+// Stream2 streams all key-value pairs of the SetMultimap.
+func (mm *SetMultimap[K, V]) Stream2(yield func(K, V) bool) {
+	for key, set := range mm.m.Stream2 {
+		for value := range set.Stream {
+			if !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys streams the distinct keys of the SetMultimap.
+func (mm *SetMultimap[K, V]) Keys(yield func(K) bool) {
+	mm.m.Keys(yield)
+}
+
+// Map returns the underlying map.
+func (mm *SetMultimap[K, V]) Map() maps.Map[K, sets.Set[V]] {
+	return mm.m
+}
@@ -0,0 +1,66 @@
+package multimap
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSetMultimap(t *testing.T) {
+	mm := NewTreeSetMultimap[string, int]()
+	mm.Put("b", 3)
+	mm.Put("a", 2)
+	mm.Put("b", 1)
+	mm.Put("b", 3)
+
+	if mm.KeyCount() != 2 || mm.ValueCount() != 3 {
+		t.Fatalf("KeyCount() = %d, ValueCount() = %d", mm.KeyCount(), mm.ValueCount())
+	}
+
+	if values := mm.GetAll("b"); !slices.Equal(values, []int{1, 3}) {
+		t.Errorf("GetAll(b) = %v", values)
+	}
+
+	if !mm.ContainsEntry("b", 3) || mm.ContainsEntry("a", 3) || mm.ContainsEntry("c", 1) {
+		t.Error("ContainsEntry() is wrong")
+	}
+
+	mm.RemoveValue("b", 5)
+	if mm.ValueCount() != 3 {
+		t.Errorf("removing a missing value changed ValueCount() to %d", mm.ValueCount())
+	}
+
+	mm.RemoveValue("a", 2)
+	if mm.Contains("a") || mm.ValueCount() != 2 {
+		t.Error("key without values was not removed")
+	}
+
+	var keys []string
+	for key := range mm.Keys {
+		keys = append(keys, key)
+	}
+
+	if !slices.Equal(keys, []string{"b"}) {
+		t.Errorf("Keys() = %v", keys)
+	}
+}
+
+func TestSetMultimapClone(t *testing.T) {
+	mm := NewHashSetMultimap[int, int]()
+	for i := range 10 {
+		mm.Put(i%3, i)
+		mm.Put(i%3, i)
+	}
+
+	cloned := mm.Clone()
+	cloned.Put(0, 100)
+	cloned.RemoveValue(1, 4)
+	cloned.RemoveAll(2)
+
+	if mm.ValueCount() != 10 || !mm.ContainsEntry(1, 4) || mm.ContainsEntry(0, 100) {
+		t.Error("modifying the clone changed the original")
+	}
+
+	if cloned.ValueCount() != 7 || cloned.KeyCount() != 2 {
+		t.Errorf("clone has %d keys and %d values", cloned.KeyCount(), cloned.ValueCount())
+	}
+}
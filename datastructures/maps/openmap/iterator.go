@@ -0,0 +1,76 @@
+package openmap
+
+import "github.com/djordje200179/extendedlibrary/misc"
+
+// Iterator is an iterator over a Map.
+//
+// Iteration starts right after an empty slot.
+// Removing entries only shifts the following entries
+// of the same cluster one slot back, so no entry
+// can be skipped or visited twice.
+type Iterator[K, V any] struct {
+	m *Map[K, V]
+
+	start, offset int
+}
+
+func (it *Iterator[K, V]) index() int {
+	return (it.start + it.offset) & int(it.m.mask())
+}
+
+func (it *Iterator[K, V]) skipEmpty() {
+	for it.offset < len(it.m.slots) && it.m.slots[it.index()].probe == 0 {
+		it.offset++
+	}
+}
+
+func (it *Iterator[K, V]) current() *slot[K, V] {
+	return &it.m.slots[it.index()]
+}
+
+// Valid returns true if it points to a valid entry.
+func (it *Iterator[K, V]) Valid() bool {
+	return it.offset < len(it.m.slots)
+}
+
+// Move moves the iterator to the next entry.
+func (it *Iterator[K, V]) Move() {
+	if !it.Valid() {
+		return
+	}
+
+	it.offset++
+	it.skipEmpty()
+}
+
+// Get returns the current entry as a key-value pair.
+func (it *Iterator[K, V]) Get() misc.Pair[K, V] {
+	return misc.MakePair(it.Key(), it.Value())
+}
+
+// Key returns the key of the current entry.
+func (it *Iterator[K, V]) Key() K {
+	return it.current().key
+}
+
+// Value returns the value of the current entry.
+func (it *Iterator[K, V]) Value() V {
+	return it.current().value
+}
+
+// ValueRef returns a reference to the value of the current entry.
+func (it *Iterator[K, V]) ValueRef() *V {
+	return &it.current().value
+}
+
+// SetValue sets the value of the current entry.
+func (it *Iterator[K, V]) SetValue(value V) {
+	it.current().value = value
+}
+
+// Remove removes the current entry from the map.
+// The iterator will point to the next entry afterward.
+func (it *Iterator[K, V]) Remove() {
+	it.m.removeAt(it.index())
+	it.skipEmpty()
+}
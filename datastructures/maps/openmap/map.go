@@ -0,0 +1,292 @@
+package openmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+	"slices"
+)
+
+const minCapacity = 8
+
+type slot[K, V any] struct {
+	key   K
+	value V
+
+	hash uint64
+	// probe is the distance from the ideal slot increased by one.
+	// Zero means that the slot is empty.
+	probe int
+}
+
+// Map is a hash map based on open addressing
+// with Robin Hood hashing.
+//
+// Keys are hashed and compared through the given
+// hashing.Hasher and hashing.Equaler, so they don't need
+// to be comparable. This allows using slices, structs with
+// slices or case-insensitive strings as keys.
+//
+// References to values are valid only until
+// the next modification of the Map.
+type Map[K, V any] struct {
+	slots   []slot[K, V]
+	entries int
+
+	hasher  hashing.Hasher[K]
+	equaler hashing.Equaler[K]
+}
+
+// New creates an empty Map with the specified
+// hashing.Hasher and hashing.Equaler.
+func New[K, V any](hasher hashing.Hasher[K], equaler hashing.Equaler[K]) *Map[K, V] {
+	return &Map[K, V]{
+		hasher:  hasher,
+		equaler: equaler,
+	}
+}
+
+// NewWithCapacity creates an empty Map with the specified
+// hashing.Hasher, hashing.Equaler and capacity.
+func NewWithCapacity[K, V any](hasher hashing.Hasher[K], equaler hashing.Equaler[K], capacity int) *Map[K, V] {
+	m := New[K, V](hasher, equaler)
+	m.resize(capacity)
+
+	return m
+}
+
+// NewFromIterable creates a Map with the specified hashing.Hasher
+// and hashing.Equaler from the specified iter.Iterable.
+func NewFromIterable[K, V any](hasher hashing.Hasher[K], equaler hashing.Equaler[K], iterable iter.Iterable[misc.Pair[K, V]]) *Map[K, V] {
+	var m *Map[K, V]
+	if finiteIter, ok := any(iterable).(iter.FiniteIterable[misc.Pair[K, V]]); ok {
+		m = NewWithCapacity[K, V](hasher, equaler, finiteIter.Size())
+	} else {
+		m = New[K, V](hasher, equaler)
+	}
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		m.Set(entry.First, entry.Second)
+	}
+
+	return m
+}
+
+func (m *Map[K, V]) mask() uint64 {
+	return uint64(len(m.slots) - 1)
+}
+
+func (m *Map[K, V]) resize(capacity int) {
+	slotCount := minCapacity
+	for slotCount*7/8 < capacity {
+		slotCount *= 2
+	}
+
+	if slotCount <= len(m.slots) {
+		return
+	}
+
+	oldSlots := m.slots
+	m.slots = make([]slot[K, V], slotCount)
+
+	for _, s := range oldSlots {
+		if s.probe != 0 {
+			m.place(s)
+		}
+	}
+}
+
+func (m *Map[K, V]) place(entry slot[K, V]) {
+	entry.probe = 1
+	for index := entry.hash & m.mask(); ; index = (index + 1) & m.mask() {
+		curr := &m.slots[index]
+
+		if curr.probe == 0 {
+			*curr = entry
+			return
+		}
+
+		if curr.probe < entry.probe {
+			*curr, entry = entry, *curr
+		}
+
+		entry.probe++
+	}
+}
+
+func (m *Map[K, V]) find(key K) int {
+	if m.entries == 0 {
+		return -1
+	}
+
+	hash := m.hasher(key)
+	for index, probe := hash&m.mask(), 1; ; index, probe = (index+1)&m.mask(), probe+1 {
+		curr := &m.slots[index]
+
+		if curr.probe < probe {
+			return -1
+		}
+
+		if curr.hash == hash && m.equaler(curr.key, key) {
+			return int(index)
+		}
+	}
+}
+
+func (m *Map[K, V]) removeAt(index int) {
+	for {
+		next := (index + 1) & int(m.mask())
+		if m.slots[next].probe <= 1 {
+			m.slots[index] = slot[K, V]{}
+			break
+		}
+
+		m.slots[index] = m.slots[next]
+		m.slots[index].probe--
+
+		index = next
+	}
+
+	m.entries--
+}
+
+// Size returns the number of entries in the Map.
+func (m *Map[K, V]) Size() int {
+	return m.entries
+}
+
+// Capacity returns the number of entries that
+// can be stored without reallocating the memory.
+func (m *Map[K, V]) Capacity() int {
+	return len(m.slots) * 7 / 8
+}
+
+// Contains returns true if the Map contains the specified key.
+func (m *Map[K, V]) Contains(key K) bool {
+	return m.find(key) != -1
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (m *Map[K, V]) TryGet(key K) (V, bool) {
+	index := m.find(key)
+	if index == -1 {
+		var zero V
+		return zero, false
+	}
+
+	return m.slots[index].value, true
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (m *Map[K, V]) Get(key K) V {
+	return *m.GetRef(key)
+}
+
+// GetRef returns a reference to the value associated with the specified key.
+// Panics if the key is not present.
+//
+// The reference is valid only until the next modification of the Map.
+func (m *Map[K, V]) GetRef(key K) *V {
+	index := m.find(key)
+	if index == -1 {
+		panic(maps.MissingKeyError[K]{Key: key})
+	}
+
+	return &m.slots[index].value
+}
+
+// Set sets the value associated with the specified key.
+func (m *Map[K, V]) Set(key K, value V) {
+	if index := m.find(key); index != -1 {
+		m.slots[index].value = value
+		return
+	}
+
+	if m.entries+1 > m.Capacity() {
+		m.resize(m.entries + 1)
+	}
+
+	m.place(slot[K, V]{
+		key:   key,
+		value: value,
+		hash:  m.hasher(key),
+	})
+	m.entries++
+}
+
+// Remove removes the entry with the specified key.
+// Does nothing if the key is not present.
+func (m *Map[K, V]) Remove(key K) {
+	if index := m.find(key); index != -1 {
+		m.removeAt(index)
+	}
+}
+
+// Clear removes all entries from the Map.
+func (m *Map[K, V]) Clear() {
+	clear(m.slots)
+	m.entries = 0
+}
+
+// Clone returns a shallow copy of the Map.
+func (m *Map[K, V]) Clone() maps.Map[K, V] {
+	return &Map[K, V]{
+		slots:   slices.Clone(m.slots),
+		entries: m.entries,
+		hasher:  m.hasher,
+		equaler: m.equaler,
+	}
+}
+
+// Iterator returns an iter.Iterator over the Map.
+func (m *Map[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return m.MapIterator()
+}
+
+// MapIterator returns an iterator over the Map.
+//
+// Entries can be removed through the iterator,
+// but the Map must not be modified in other ways while iterating.
+func (m *Map[K, V]) MapIterator() maps.Iterator[K, V] {
+	it := &Iterator[K, V]{m: m}
+
+	if m.entries > 0 {
+		for m.slots[it.start].probe != 0 {
+			it.start++
+		}
+
+		it.skipEmpty()
+	} else {
+		it.offset = len(m.slots)
+	}
+
+	return it
+}
+
+// Stream2 streams over the entries in the Map.
+func (m *Map[K, V]) Stream2(yield func(K, V) bool) {
+	for i := range m.slots {
+		s := &m.slots[i]
+		if s.probe == 0 {
+			continue
+		}
+
+		if !yield(s.key, s.value) {
+			break
+		}
+	}
+}
+
+// Keys streams the keys of the Map.
+func (m *Map[K, V]) Keys(yield func(K) bool) {
+	m.Stream2(func(key K, _ V) bool { return yield(key) })
+}
+
+// Values streams the values of the Map.
+func (m *Map[K, V]) Values(yield func(V) bool) {
+	m.Stream2(func(_ K, value V) bool { return yield(value) })
+}
@@ -0,0 +1,76 @@
+package openmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+	"math/rand"
+	"testing"
+)
+
+func checkEqual(t *testing.T, m *Map[int, int], expected map[int]int) {
+	t.Helper()
+
+	if m.Size() != len(expected) {
+		t.Fatalf("Size() = %d, expected %d", m.Size(), len(expected))
+	}
+
+	streamed := 0
+	for key, value := range m.Stream2 {
+		if expectedValue, ok := expected[key]; !ok || value != expectedValue {
+			t.Fatalf("streamed (%d, %d), expected %d, %v", key, value, expectedValue, ok)
+		}
+
+		streamed++
+	}
+
+	if streamed != len(expected) {
+		t.Fatalf("streamed %d entries, expected %d", streamed, len(expected))
+	}
+}
+
+func testRandomEdits(t *testing.T, hasher hashing.Hasher[int]) {
+	random := rand.New(rand.NewSource(1))
+
+	m := New[int, int](hasher, hashing.DefaultEqualer[int]())
+	expected := make(map[int]int)
+
+	for i := range 50000 {
+		key := random.Intn(500)
+
+		switch random.Intn(5) {
+		case 0, 1:
+			m.Set(key, i)
+			expected[key] = i
+		case 2:
+			m.Remove(key)
+			delete(expected, key)
+		case 3:
+			value, ok := m.TryGet(key)
+			if expectedValue, expectedOk := expected[key]; ok != expectedOk || value != expectedValue {
+				t.Fatalf("TryGet(%d) = %d, %v, expected %d, %v", key, value, ok, expectedValue, expectedOk)
+			}
+		case 4:
+			for it := m.MapIterator(); it.Valid(); {
+				if it.Key()%7 == key%7 {
+					delete(expected, it.Key())
+					it.Remove()
+				} else {
+					it.Move()
+				}
+			}
+		}
+
+		if i%1000 == 0 {
+			checkEqual(t, m, expected)
+		}
+	}
+
+	checkEqual(t, m, expected)
+}
+
+func TestRandomEdits(t *testing.T) {
+	testRandomEdits(t, hashing.DefaultHasher[int]())
+}
+
+func TestRandomEditsWithCollisions(t *testing.T) {
+	testRandomEdits(t, func(key int) uint64 { return uint64(key % 13) })
+}
@@ -0,0 +1,49 @@
+package ptree
+
+import "github.com/djordje200179/extendedlibrary/misc"
+
+// Iterator is an iterator over a Tree.
+//
+// Trees are immutable, so the iterator
+// is not affected by newer versions of the Tree.
+type Iterator[K, V any] struct {
+	stack []*node[K, V]
+}
+
+func (it *Iterator[K, V]) pushLeft(curr *node[K, V]) {
+	for ; curr != nil; curr = curr.leftChild {
+		it.stack = append(it.stack, curr)
+	}
+}
+
+// Valid returns true if it points to a valid entry.
+func (it *Iterator[K, V]) Valid() bool {
+	return len(it.stack) > 0
+}
+
+// Move moves the iterator to the next entry.
+func (it *Iterator[K, V]) Move() {
+	if len(it.stack) == 0 {
+		return
+	}
+
+	curr := it.stack[len(it.stack)-1]
+	it.stack = it.stack[:len(it.stack)-1]
+
+	it.pushLeft(curr.rightChild)
+}
+
+// Get returns the current entry as a key-value pair.
+func (it *Iterator[K, V]) Get() misc.Pair[K, V] {
+	return misc.MakePair(it.Key(), it.Value())
+}
+
+// Key returns the key of the current entry.
+func (it *Iterator[K, V]) Key() K {
+	return it.stack[len(it.stack)-1].key
+}
+
+// Value returns the value of the current entry.
+func (it *Iterator[K, V]) Value() V {
+	return it.stack[len(it.stack)-1].value
+}
@@ -0,0 +1,137 @@
+package ptree
+
+import "github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+
+type node[K, V any] struct {
+	key   K
+	value V
+
+	height int
+	size   int
+
+	leftChild, rightChild *node[K, V]
+}
+
+func nodeHeight[K, V any](node *node[K, V]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.height
+}
+
+func nodeSize[K, V any](node *node[K, V]) int {
+	if node == nil {
+		return 0
+	}
+
+	return node.size
+}
+
+func newNode[K, V any](key K, value V, leftChild, rightChild *node[K, V]) *node[K, V] {
+	return &node[K, V]{
+		key:   key,
+		value: value,
+
+		height: max(nodeHeight(leftChild), nodeHeight(rightChild)) + 1,
+		size:   nodeSize(leftChild) + nodeSize(rightChild) + 1,
+
+		leftChild:  leftChild,
+		rightChild: rightChild,
+	}
+}
+
+func balance[K, V any](key K, value V, leftChild, rightChild *node[K, V]) *node[K, V] {
+	leftHeight, rightHeight := nodeHeight(leftChild), nodeHeight(rightChild)
+
+	switch {
+	case leftHeight > rightHeight+1:
+		if nodeHeight(leftChild.leftChild) >= nodeHeight(leftChild.rightChild) {
+			return newNode(
+				leftChild.key, leftChild.value,
+				leftChild.leftChild,
+				newNode(key, value, leftChild.rightChild, rightChild),
+			)
+		}
+
+		middle := leftChild.rightChild
+		return newNode(
+			middle.key, middle.value,
+			newNode(leftChild.key, leftChild.value, leftChild.leftChild, middle.leftChild),
+			newNode(key, value, middle.rightChild, rightChild),
+		)
+	case rightHeight > leftHeight+1:
+		if nodeHeight(rightChild.rightChild) >= nodeHeight(rightChild.leftChild) {
+			return newNode(
+				rightChild.key, rightChild.value,
+				newNode(key, value, leftChild, rightChild.leftChild),
+				rightChild.rightChild,
+			)
+		}
+
+		middle := rightChild.leftChild
+		return newNode(
+			middle.key, middle.value,
+			newNode(key, value, leftChild, middle.leftChild),
+			newNode(rightChild.key, rightChild.value, middle.rightChild, rightChild.rightChild),
+		)
+	default:
+		return newNode(key, value, leftChild, rightChild)
+	}
+}
+
+func insert[K, V any](curr *node[K, V], key K, value V, comparator comparison.Comparator[K]) *node[K, V] {
+	if curr == nil {
+		return newNode[K, V](key, value, nil, nil)
+	}
+
+	switch comparator(key, curr.key) {
+	case comparison.FirstSmaller:
+		return balance(curr.key, curr.value, insert(curr.leftChild, key, value, comparator), curr.rightChild)
+	case comparison.FirstBigger:
+		return balance(curr.key, curr.value, curr.leftChild, insert(curr.rightChild, key, value, comparator))
+	default:
+		return newNode(key, value, curr.leftChild, curr.rightChild)
+	}
+}
+
+func removeMin[K, V any](curr *node[K, V]) (*node[K, V], *node[K, V]) {
+	if curr.leftChild == nil {
+		return curr.rightChild, curr
+	}
+
+	newLeftChild, minNode := removeMin(curr.leftChild)
+	return balance(curr.key, curr.value, newLeftChild, curr.rightChild), minNode
+}
+
+func remove[K, V any](curr *node[K, V], key K, comparator comparison.Comparator[K]) (*node[K, V], bool) {
+	if curr == nil {
+		return nil, false
+	}
+
+	switch comparator(key, curr.key) {
+	case comparison.FirstSmaller:
+		newLeftChild, removed := remove(curr.leftChild, key, comparator)
+		if !removed {
+			return curr, false
+		}
+
+		return balance(curr.key, curr.value, newLeftChild, curr.rightChild), true
+	case comparison.FirstBigger:
+		newRightChild, removed := remove(curr.rightChild, key, comparator)
+		if !removed {
+			return curr, false
+		}
+
+		return balance(curr.key, curr.value, curr.leftChild, newRightChild), true
+	default:
+		if curr.leftChild == nil {
+			return curr.rightChild, true
+		} else if curr.rightChild == nil {
+			return curr.leftChild, true
+		}
+
+		newRightChild, minNode := removeMin(curr.rightChild)
+		return balance(minNode.key, minNode.value, curr.leftChild, newRightChild), true
+	}
+}
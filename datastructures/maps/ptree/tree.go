@@ -0,0 +1,196 @@
+package ptree
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+)
+
+// Tree is a persistent (immutable) map based on an AVL tree.
+//
+// Every modification returns a new version of the Tree
+// and leaves the original one unchanged.
+// Versions share all nodes that were not on the path
+// of the modification, so modifications take O(log n)
+// time and memory. It is safe to use any version
+// concurrently from multiple goroutines.
+type Tree[K, V any] struct {
+	root *node[K, V]
+
+	comparator comparison.Comparator[K]
+}
+
+// NewWithComparator creates an empty Tree with the specified comparator.
+func NewWithComparator[K, V any](comparator comparison.Comparator[K]) Tree[K, V] {
+	return Tree[K, V]{comparator: comparator}
+}
+
+// New creates an empty Tree with the default comparator for ordered keys.
+func New[K cmp.Ordered, V any]() Tree[K, V] {
+	return NewWithComparator[K, V](cmp.Compare[K])
+}
+
+// NewWithComparatorFromIterable creates a Tree with the specified comparator from the specified iter.Iterable.
+func NewWithComparatorFromIterable[K, V any](comparator comparison.Comparator[K], iterable iter.Iterable[misc.Pair[K, V]]) Tree[K, V] {
+	tree := NewWithComparator[K, V](comparator)
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		tree.root = insert(tree.root, entry.First, entry.Second, comparator)
+	}
+
+	return tree
+}
+
+// NewFromIterable creates a Tree from the specified iter.Iterable.
+func NewFromIterable[K cmp.Ordered, V any](iterable iter.Iterable[misc.Pair[K, V]]) Tree[K, V] {
+	return NewWithComparatorFromIterable[K, V](cmp.Compare[K], iterable)
+}
+
+func (tree Tree[K, V]) getNode(key K) *node[K, V] {
+	for curr := tree.root; curr != nil; {
+		switch tree.comparator(key, curr.key) {
+		case comparison.FirstSmaller:
+			curr = curr.leftChild
+		case comparison.FirstBigger:
+			curr = curr.rightChild
+		case comparison.Equal:
+			return curr
+		}
+	}
+
+	return nil
+}
+
+// Size returns the number of entries in the Tree.
+func (tree Tree[K, V]) Size() int {
+	return nodeSize(tree.root)
+}
+
+// Contains returns true if the Tree contains the specified key.
+func (tree Tree[K, V]) Contains(key K) bool {
+	return tree.getNode(key) != nil
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (tree Tree[K, V]) TryGet(key K) (V, bool) {
+	node := tree.getNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+
+	return node.value, true
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (tree Tree[K, V]) Get(key K) V {
+	node := tree.getNode(key)
+	if node == nil {
+		panic(maps.MissingKeyError[K]{Key: key})
+	}
+
+	return node.value
+}
+
+// Set returns a new version of the Tree in which
+// the specified key is associated with the specified value.
+func (tree Tree[K, V]) Set(key K, value V) Tree[K, V] {
+	return Tree[K, V]{
+		root:       insert(tree.root, key, value, tree.comparator),
+		comparator: tree.comparator,
+	}
+}
+
+// Remove returns a new version of the Tree
+// without the entry with the specified key.
+// If the key is not present, the same version is returned.
+func (tree Tree[K, V]) Remove(key K) Tree[K, V] {
+	newRoot, _ := remove(tree.root, key, tree.comparator)
+
+	return Tree[K, V]{
+		root:       newRoot,
+		comparator: tree.comparator,
+	}
+}
+
+// Clear returns an empty Tree with the same comparator.
+func (tree Tree[K, V]) Clear() Tree[K, V] {
+	return NewWithComparator[K, V](tree.comparator)
+}
+
+// First returns the entry with the smallest key.
+// Returns false if the Tree is empty.
+func (tree Tree[K, V]) First() (misc.Pair[K, V], bool) {
+	if tree.root == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	curr := tree.root
+	for curr.leftChild != nil {
+		curr = curr.leftChild
+	}
+
+	return misc.MakePair(curr.key, curr.value), true
+}
+
+// Last returns the entry with the greatest key.
+// Returns false if the Tree is empty.
+func (tree Tree[K, V]) Last() (misc.Pair[K, V], bool) {
+	if tree.root == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	curr := tree.root
+	for curr.rightChild != nil {
+		curr = curr.rightChild
+	}
+
+	return misc.MakePair(curr.key, curr.value), true
+}
+
+// Iterator returns an iter.Iterator over the entries
+// in the order of the keys.
+func (tree Tree[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return tree.TreeIterator()
+}
+
+// TreeIterator returns an Iterator over the entries
+// in the order of the keys.
+func (tree Tree[K, V]) TreeIterator() *Iterator[K, V] {
+	it := &Iterator[K, V]{}
+	it.pushLeft(tree.root)
+
+	return it
+}
+
+// Stream2 streams over the entries in the Tree.
+func (tree Tree[K, V]) Stream2(yield func(K, V) bool) {
+	for it := tree.TreeIterator(); it.Valid(); it.Move() {
+		if !yield(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
+
+// Keys streams the keys of the Tree.
+func (tree Tree[K, V]) Keys(yield func(K) bool) {
+	for it := tree.TreeIterator(); it.Valid(); it.Move() {
+		if !yield(it.Key()) {
+			return
+		}
+	}
+}
+
+// Values streams the values of the Tree.
+func (tree Tree[K, V]) Values(yield func(V) bool) {
+	for it := tree.TreeIterator(); it.Valid(); it.Move() {
+		if !yield(it.Value()) {
+			return
+		}
+	}
+}
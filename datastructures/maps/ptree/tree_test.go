@@ -0,0 +1,160 @@
+package ptree
+
+import (
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func checkAVL(t *testing.T, curr *node[int, int]) {
+	t.Helper()
+
+	if curr == nil {
+		return
+	}
+
+	checkAVL(t, curr.leftChild)
+	checkAVL(t, curr.rightChild)
+
+	leftHeight, rightHeight := nodeHeight(curr.leftChild), nodeHeight(curr.rightChild)
+	if leftHeight-rightHeight > 1 || rightHeight-leftHeight > 1 {
+		t.Fatalf("node %d has children of heights %d and %d", curr.key, leftHeight, rightHeight)
+	}
+
+	if curr.height != max(leftHeight, rightHeight)+1 {
+		t.Fatalf("node %d has height %d", curr.key, curr.height)
+	}
+
+	if curr.size != nodeSize(curr.leftChild)+nodeSize(curr.rightChild)+1 {
+		t.Fatalf("node %d has size %d", curr.key, curr.size)
+	}
+}
+
+func checkEntries(t *testing.T, tree Tree[int, int], expected map[int]int) {
+	t.Helper()
+
+	checkAVL(t, tree.root)
+
+	if tree.Size() != len(expected) {
+		t.Fatalf("Size() = %d, expected %d", tree.Size(), len(expected))
+	}
+
+	keys := slices.Collect(tree.Keys)
+	if !slices.Equal(keys, slices.Sorted(maps.Keys(expected))) {
+		t.Fatalf("keys are %v", keys)
+	}
+
+	for key, value := range tree.Stream2 {
+		if expected[key] != value {
+			t.Fatalf("key %d has value %d, expected %d", key, value, expected[key])
+		}
+	}
+}
+
+func TestVersions(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	versions := []Tree[int, int]{New[int, int]()}
+	expected := []map[int]int{{}}
+
+	for range 500 {
+		tree, entries := versions[len(versions)-1], maps.Clone(expected[len(expected)-1])
+		if i := random.Intn(len(versions)); random.Intn(4) == 0 {
+			tree, entries = versions[i], maps.Clone(expected[i])
+		}
+
+		key := random.Intn(100)
+		if random.Intn(3) == 0 {
+			tree = tree.Remove(key)
+			delete(entries, key)
+		} else {
+			tree = tree.Set(key, random.Int())
+			entries[key] = tree.Get(key)
+		}
+
+		versions = append(versions, tree)
+		expected = append(expected, entries)
+	}
+
+	for i, tree := range versions {
+		checkEntries(t, tree, expected[i])
+	}
+}
+
+func countShared(curr *node[int, int], nodes map[*node[int, int]]bool) int {
+	if curr == nil {
+		return 0
+	}
+
+	count := countShared(curr.leftChild, nodes) + countShared(curr.rightChild, nodes)
+	if nodes[curr] {
+		count++
+	}
+
+	return count
+}
+
+func collectNodes(curr *node[int, int], nodes map[*node[int, int]]bool) {
+	if curr == nil {
+		return
+	}
+
+	nodes[curr] = true
+	collectNodes(curr.leftChild, nodes)
+	collectNodes(curr.rightChild, nodes)
+}
+
+func TestStructuralSharing(t *testing.T) {
+	tree := New[int, int]()
+	for i := range 1024 {
+		tree = tree.Set(i, i)
+	}
+
+	nodes := make(map[*node[int, int]]bool)
+	collectNodes(tree.root, nodes)
+
+	updated := tree.Set(500, -1)
+	if shared := countShared(updated.root, nodes); shared < tree.Size()-tree.root.height {
+		t.Errorf("Set() shares only %d nodes", shared)
+	}
+
+	removed := tree.Remove(500)
+	if shared := countShared(removed.root, nodes); shared < tree.Size()-2*tree.root.height {
+		t.Errorf("Remove() shares only %d nodes", shared)
+	}
+
+	if tree.Remove(5000).root != tree.root {
+		t.Error("Remove() of a missing key created a new version")
+	}
+
+	if tree.Get(500) != 500 || !tree.Contains(500) {
+		t.Error("original version was modified")
+	}
+}
+
+func TestIteratorIgnoresNewVersions(t *testing.T) {
+	tree := New[int, int]().Set(1, 1).Set(2, 2).Set(3, 3)
+
+	it := tree.TreeIterator()
+	tree = tree.Remove(2).Set(4, 4)
+
+	var keys []int
+	for ; it.Valid(); it.Move() {
+		keys = append(keys, it.Key())
+	}
+
+	if !slices.Equal(keys, []int{1, 2, 3}) {
+		t.Errorf("iterated keys are %v", keys)
+	}
+
+	if first, ok := tree.First(); !ok || first.First != 1 {
+		t.Errorf("First() = %v, %v", first, ok)
+	}
+	if last, ok := tree.Last(); !ok || last.First != 4 {
+		t.Errorf("Last() = %v, %v", last, ok)
+	}
+	if _, ok := tree.Clear().First(); ok {
+		t.Error("First() of a cleared tree returned an entry")
+	}
+}
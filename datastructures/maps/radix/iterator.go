@@ -0,0 +1,155 @@
+package radix
+
+import "github.com/djordje200179/extendedlibrary/misc"
+
+type frame[V any] struct {
+	node  *node[V]
+	key   string
+	index int
+}
+
+// Iterator is an iterator over a Tree.
+type Iterator[V any] struct {
+	tree *Tree[V]
+
+	path []frame[V]
+}
+
+func (it *Iterator[V]) top() frame[V] {
+	return it.path[len(it.path)-1]
+}
+
+func (it *Iterator[V]) pushChild(index int) {
+	top := it.top()
+	child := top.node.children[index]
+
+	it.path = append(it.path, frame[V]{child, top.key + child.label, index})
+}
+
+func (it *Iterator[V]) skipSubtree() {
+	for {
+		popped := it.top()
+		it.path = it.path[:len(it.path)-1]
+
+		if len(it.path) == 0 {
+			return
+		}
+
+		if popped.index+1 < len(it.top().node.children) {
+			it.pushChild(popped.index + 1)
+			return
+		}
+	}
+}
+
+func (it *Iterator[V]) step() {
+	if len(it.top().node.children) > 0 {
+		it.pushChild(0)
+	} else {
+		it.skipSubtree()
+	}
+}
+
+func (it *Iterator[V]) settle() {
+	for len(it.path) > 0 && !it.top().node.hasValue {
+		it.step()
+	}
+}
+
+func (it *Iterator[V]) seekFirst() {
+	it.path = append(it.path[:0], frame[V]{node: it.tree.root})
+	it.settle()
+}
+
+func (it *Iterator[V]) seek(key string, inclusive bool) {
+	it.path = append(it.path[:0], frame[V]{node: it.tree.root})
+
+	for {
+		top := it.top()
+
+		if key == "" {
+			if inclusive && top.node.hasValue {
+				return
+			}
+
+			it.step()
+			break
+		}
+
+		index, found := top.node.childIndex(key[0])
+		if !found {
+			if index < len(top.node.children) {
+				it.pushChild(index)
+			} else {
+				it.skipSubtree()
+			}
+
+			break
+		}
+
+		it.pushChild(index)
+
+		label := it.top().node.label
+		common := commonPrefixLength(key, label)
+		if common == len(label) {
+			key = key[common:]
+			continue
+		}
+
+		if common < len(key) && label[common] < key[common] {
+			it.skipSubtree()
+		}
+
+		break
+	}
+
+	it.settle()
+}
+
+// Valid returns true if it points to a valid entry.
+func (it *Iterator[V]) Valid() bool {
+	return len(it.path) > 0
+}
+
+// Move moves the iterator to the next entry.
+func (it *Iterator[V]) Move() {
+	if len(it.path) == 0 {
+		return
+	}
+
+	it.step()
+	it.settle()
+}
+
+// Get returns the current entry as a key-value pair.
+func (it *Iterator[V]) Get() misc.Pair[string, V] {
+	return misc.MakePair(it.Key(), it.Value())
+}
+
+// Key returns the key of the current entry.
+func (it *Iterator[V]) Key() string {
+	return it.top().key
+}
+
+// Value returns the value of the current entry.
+func (it *Iterator[V]) Value() V {
+	return it.top().node.value
+}
+
+// ValueRef returns a reference to the value of the current entry.
+func (it *Iterator[V]) ValueRef() *V {
+	return &it.top().node.value
+}
+
+// SetValue sets the value of the current entry.
+func (it *Iterator[V]) SetValue(value V) {
+	it.top().node.value = value
+}
+
+// Remove removes the current entry from the Tree.
+// The iterator will point to the next entry afterward.
+func (it *Iterator[V]) Remove() {
+	key := it.Key()
+	it.tree.Remove(key)
+	it.seek(key, false)
+}
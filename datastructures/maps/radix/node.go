@@ -0,0 +1,104 @@
+package radix
+
+import "slices"
+
+type node[V any] struct {
+	label    string
+	children []*node[V]
+
+	value    V
+	hasValue bool
+}
+
+func (n *node[V]) childIndex(first byte) (int, bool) {
+	return slices.BinarySearchFunc(n.children, first, func(child *node[V], first byte) int {
+		return int(child.label[0]) - int(first)
+	})
+}
+
+func (n *node[V]) child(first byte) *node[V] {
+	index, found := n.childIndex(first)
+	if !found {
+		return nil
+	}
+
+	return n.children[index]
+}
+
+func (n *node[V]) clearValue() {
+	var zero V
+	n.value = zero
+	n.hasValue = false
+}
+
+func (n *node[V]) insertChild(index int, child *node[V]) {
+	n.children = slices.Insert(n.children, index, child)
+}
+
+func (n *node[V]) removeChild(index int) {
+	n.children = slices.Delete(n.children, index, index+1)
+}
+
+func (n *node[V]) mergeWithChild() {
+	child := n.children[0]
+
+	n.label += child.label
+	n.children = child.children
+	n.value = child.value
+	n.hasValue = child.hasValue
+}
+
+func (n *node[V]) count() int {
+	count := 0
+	if n.hasValue {
+		count++
+	}
+
+	for _, child := range n.children {
+		count += child.count()
+	}
+
+	return count
+}
+
+func (n *node[V]) stream(key string, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(key, n.value) {
+		return false
+	}
+
+	for _, child := range n.children {
+		if !child.stream(key+child.label, yield) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (n *node[V]) clone() *node[V] {
+	cloned := &node[V]{
+		label:    n.label,
+		value:    n.value,
+		hasValue: n.hasValue,
+	}
+
+	if len(n.children) > 0 {
+		cloned.children = make([]*node[V], len(n.children))
+		for i, child := range n.children {
+			cloned.children[i] = child.clone()
+		}
+	}
+
+	return cloned
+}
+
+func commonPrefixLength(first, second string) int {
+	length := min(len(first), len(second))
+	for i := 0; i < length; i++ {
+		if first[i] != second[i] {
+			return i
+		}
+	}
+
+	return length
+}
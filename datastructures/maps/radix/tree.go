@@ -0,0 +1,337 @@
+package radix
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"strings"
+)
+
+// Tree is a radix tree (compressed trie) implementation of a map
+// with string keys. Byte slices can be used as keys
+// by converting them to strings.
+//
+// Every edge is labeled with a part of the key,
+// and chains of nodes with a single child are merged,
+// so lookups take time proportional to the length of the key
+// and not to the number of entries.
+// Entries are iterated in the lexicographic order of the keys.
+type Tree[V any] struct {
+	root    *node[V]
+	entries int
+}
+
+// New creates an empty Tree.
+func New[V any]() *Tree[V] {
+	return &Tree[V]{root: &node[V]{}}
+}
+
+// NewFromIterable creates a Tree from the specified iter.Iterable.
+func NewFromIterable[V any](iterable iter.Iterable[misc.Pair[string, V]]) *Tree[V] {
+	tree := New[V]()
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		tree.Set(entry.First, entry.Second)
+	}
+
+	return tree
+}
+
+func (tree *Tree[V]) walk(key string) (path []*node[V], indices []int, ok bool) {
+	curr := tree.root
+	path = append(path, curr)
+
+	for key != "" {
+		index, found := curr.childIndex(key[0])
+		if !found {
+			return nil, nil, false
+		}
+
+		curr = curr.children[index]
+		if !strings.HasPrefix(key, curr.label) {
+			return nil, nil, false
+		}
+
+		key = key[len(curr.label):]
+		path = append(path, curr)
+		indices = append(indices, index)
+	}
+
+	return path, indices, true
+}
+
+func (tree *Tree[V]) locatePrefix(prefix string) (path []*node[V], indices []int, key string, ok bool) {
+	curr := tree.root
+	path = append(path, curr)
+
+	for prefix != "" {
+		index, found := curr.childIndex(prefix[0])
+		if !found {
+			return nil, nil, "", false
+		}
+
+		curr = curr.children[index]
+		common := commonPrefixLength(prefix, curr.label)
+		if common < len(prefix) && common < len(curr.label) {
+			return nil, nil, "", false
+		}
+
+		key += curr.label
+		prefix = prefix[common:]
+		path = append(path, curr)
+		indices = append(indices, index)
+	}
+
+	return path, indices, key, true
+}
+
+func (tree *Tree[V]) getNode(key string) *node[V] {
+	path, _, ok := tree.walk(key)
+	if !ok || !path[len(path)-1].hasValue {
+		return nil
+	}
+
+	return path[len(path)-1]
+}
+
+func (tree *Tree[V]) compact(path []*node[V], indices []int) {
+	if len(path) == 1 {
+		return
+	}
+
+	n, parent := path[len(path)-1], path[len(path)-2]
+
+	switch len(n.children) {
+	case 0:
+		if n.hasValue {
+			return
+		}
+
+		parent.removeChild(indices[len(indices)-1])
+		if len(path) > 2 && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeWithChild()
+		}
+	case 1:
+		if !n.hasValue {
+			n.mergeWithChild()
+		}
+	}
+}
+
+// Size returns the number of entries in the Tree.
+func (tree *Tree[V]) Size() int {
+	return tree.entries
+}
+
+// Contains returns true if the Tree contains the specified key.
+func (tree *Tree[V]) Contains(key string) bool {
+	return tree.getNode(key) != nil
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (tree *Tree[V]) TryGet(key string) (V, bool) {
+	node := tree.getNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+
+	return node.value, true
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (tree *Tree[V]) Get(key string) V {
+	return *tree.GetRef(key)
+}
+
+// GetRef returns a reference to the value associated with the specified key.
+// Panics if the key is not present.
+func (tree *Tree[V]) GetRef(key string) *V {
+	node := tree.getNode(key)
+	if node == nil {
+		panic(maps.MissingKeyError[string]{Key: key})
+	}
+
+	return &node.value
+}
+
+// Set sets the value associated with the specified key.
+func (tree *Tree[V]) Set(key string, value V) {
+	curr := tree.root
+	for key != "" {
+		index, found := curr.childIndex(key[0])
+		if !found {
+			curr.insertChild(index, &node[V]{label: key, value: value, hasValue: true})
+			tree.entries++
+			return
+		}
+
+		child := curr.children[index]
+		common := commonPrefixLength(key, child.label)
+		if common < len(child.label) {
+			split := &node[V]{
+				label:    child.label[:common],
+				children: []*node[V]{child},
+			}
+			child.label = child.label[common:]
+			curr.children[index] = split
+			child = split
+		}
+
+		key = key[common:]
+		curr = child
+	}
+
+	if !curr.hasValue {
+		tree.entries++
+	}
+
+	curr.value = value
+	curr.hasValue = true
+}
+
+// Remove removes the entry with the specified key.
+// Does nothing if the key is not present.
+func (tree *Tree[V]) Remove(key string) {
+	path, indices, ok := tree.walk(key)
+	if !ok || !path[len(path)-1].hasValue {
+		return
+	}
+
+	path[len(path)-1].clearValue()
+	tree.entries--
+
+	tree.compact(path, indices)
+}
+
+// Clear removes all entries from the Tree.
+func (tree *Tree[V]) Clear() {
+	tree.root = &node[V]{}
+	tree.entries = 0
+}
+
+// Clone returns a shallow copy of the Tree.
+func (tree *Tree[V]) Clone() maps.Map[string, V] {
+	return &Tree[V]{
+		root:    tree.root.clone(),
+		entries: tree.entries,
+	}
+}
+
+// Iterator returns an iter.Iterator over the entries
+// in the lexicographic order of the keys.
+func (tree *Tree[V]) Iterator() iter.Iterator[misc.Pair[string, V]] {
+	return tree.MapIterator()
+}
+
+// MapIterator returns an iterator over the entries
+// in the lexicographic order of the keys.
+func (tree *Tree[V]) MapIterator() maps.Iterator[string, V] {
+	return tree.TreeIterator()
+}
+
+// TreeIterator returns an Iterator over the entries
+// in the lexicographic order of the keys.
+func (tree *Tree[V]) TreeIterator() *Iterator[V] {
+	it := &Iterator[V]{tree: tree}
+	it.seekFirst()
+
+	return it
+}
+
+// Stream2 streams over the entries in the Tree.
+func (tree *Tree[V]) Stream2(yield func(string, V) bool) {
+	tree.root.stream("", yield)
+}
+
+// Keys streams the keys of the Tree.
+func (tree *Tree[V]) Keys(yield func(string) bool) {
+	for k := range tree.Stream2 {
+		if !yield(k) {
+			return
+		}
+	}
+}
+
+// Values streams the values of the Tree.
+func (tree *Tree[V]) Values(yield func(V) bool) {
+	for _, v := range tree.Stream2 {
+		if !yield(v) {
+			return
+		}
+	}
+}
+
+// LongestPrefixMatch returns the entry whose key is
+// the longest prefix of the specified key.
+// Returns false if no key is a prefix of the specified key.
+func (tree *Tree[V]) LongestPrefixMatch(key string) (misc.Pair[string, V], bool) {
+	var best *node[V]
+	bestLength := 0
+
+	curr, consumed := tree.root, 0
+	for {
+		if curr.hasValue {
+			best, bestLength = curr, consumed
+		}
+
+		if consumed == len(key) {
+			break
+		}
+
+		curr = curr.child(key[consumed])
+		if curr == nil || !strings.HasPrefix(key[consumed:], curr.label) {
+			break
+		}
+
+		consumed += len(curr.label)
+	}
+
+	if best == nil {
+		return misc.Pair[string, V]{}, false
+	}
+
+	return misc.MakePair(key[:bestLength], best.value), true
+}
+
+// StreamPrefix returns a stream over the entries
+// whose keys start with the specified prefix,
+// in the lexicographic order of the keys.
+func (tree *Tree[V]) StreamPrefix(prefix string) func(yield func(string, V) bool) {
+	return func(yield func(string, V) bool) {
+		path, _, key, ok := tree.locatePrefix(prefix)
+		if !ok {
+			return
+		}
+
+		path[len(path)-1].stream(key, yield)
+	}
+}
+
+// DeletePrefix removes all entries whose keys
+// start with the specified prefix.
+// Returns the number of removed entries.
+func (tree *Tree[V]) DeletePrefix(prefix string) int {
+	path, indices, _, ok := tree.locatePrefix(prefix)
+	if !ok {
+		return 0
+	}
+
+	subtree := path[len(path)-1]
+	removed := subtree.count()
+	tree.entries -= removed
+
+	if len(path) == 1 {
+		tree.root = &node[V]{}
+		return removed
+	}
+
+	subtree.children = nil
+	subtree.clearValue()
+	tree.compact(path, indices)
+
+	return removed
+}
@@ -0,0 +1,132 @@
+package radix
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPrefixes(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "r"} {
+		tree.Set(key, i)
+	}
+
+	var keys []string
+	for key := range tree.StreamPrefix("rub") {
+		keys = append(keys, key)
+	}
+	if !slices.Equal(keys, []string{"rubens", "ruber", "rubicon", "rubicundus"}) {
+		t.Errorf("StreamPrefix(\"rub\") = %v", keys)
+	}
+
+	if entry, ok := tree.LongestPrefixMatch("romanum"); !ok || entry.First != "r" {
+		t.Errorf("LongestPrefixMatch(\"romanum\") = %v, %v", entry, ok)
+	}
+
+	if removed := tree.DeletePrefix("rom"); removed != 3 {
+		t.Errorf("DeletePrefix(\"rom\") = %d", removed)
+	}
+
+	keys = keys[:0]
+	for it := tree.MapIterator(); it.Valid(); it.Move() {
+		keys = append(keys, it.Key())
+	}
+	if !slices.Equal(keys, []string{"r", "rubens", "ruber", "rubicon", "rubicundus"}) {
+		t.Errorf("keys after DeletePrefix = %v", keys)
+	}
+}
+
+func checkCompact[V any](t *testing.T, tree *Tree[V]) {
+	t.Helper()
+
+	var check func(n *node[V], isRoot bool)
+	check = func(n *node[V], isRoot bool) {
+		if !isRoot && !n.hasValue && len(n.children) < 2 {
+			t.Errorf("node %q without value has %d children", n.label, len(n.children))
+		}
+
+		for _, child := range n.children {
+			check(child, false)
+		}
+	}
+
+	check(tree.root, true)
+}
+
+func TestLongestPrefixMatchWithoutMatch(t *testing.T) {
+	tree := New[int]()
+	tree.Set("abc", 1)
+	tree.Set("abd", 2)
+
+	for _, key := range []string{"", "ab", "abx", "xyz"} {
+		if entry, ok := tree.LongestPrefixMatch(key); ok {
+			t.Errorf("LongestPrefixMatch(%q) = %v", key, entry)
+		}
+	}
+
+	tree.Set("", 0)
+	if entry, ok := tree.LongestPrefixMatch("xyz"); !ok || entry.First != "" || entry.Second != 0 {
+		t.Errorf("LongestPrefixMatch(\"xyz\") with an empty key = %v, %v", entry, ok)
+	}
+}
+
+func TestDeletePrefixEdgeCases(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"", "a", "ab", "abc", "b"} {
+		tree.Set(key, i)
+	}
+
+	for _, prefix := range []string{"c", "abcd", "ba"} {
+		if removed := tree.DeletePrefix(prefix); removed != 0 || tree.Size() != 5 {
+			t.Errorf("DeletePrefix(%q) = %d, size is %d", prefix, removed, tree.Size())
+		}
+	}
+
+	if removed := tree.DeletePrefix(""); removed != 5 || tree.Size() != 0 {
+		t.Errorf("DeletePrefix(\"\") = %d, size is %d", removed, tree.Size())
+	}
+
+	if keys := slices.Collect(tree.Keys); len(keys) != 0 {
+		t.Errorf("keys after deleting the root = %v", keys)
+	}
+
+	tree.Set("x", 1)
+	if tree.Size() != 1 || tree.Get("x") != 1 {
+		t.Error("tree is not usable after deleting the root")
+	}
+}
+
+func TestMergeAfterRemoval(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"team", "test", "toast", "te", "toaster"} {
+		tree.Set(key, i)
+	}
+	checkCompact(t, tree)
+
+	for _, key := range []string{"te", "team", "toast", "missing", "tes"} {
+		tree.Remove(key)
+		checkCompact(t, tree)
+	}
+
+	if keys := slices.Collect(tree.Keys); !slices.Equal(keys, []string{"test", "toaster"}) {
+		t.Errorf("keys after removals = %v", keys)
+	}
+
+	if len(tree.root.children) != 1 || tree.root.children[0].label != "t" {
+		t.Fatalf("root has %d children", len(tree.root.children))
+	}
+
+	var labels []string
+	for _, child := range tree.root.children[0].children {
+		labels = append(labels, child.label)
+	}
+	if !slices.Equal(labels, []string{"est", "oaster"}) {
+		t.Errorf("merged labels are %q", labels)
+	}
+
+	tree.DeletePrefix("to")
+	checkCompact(t, tree)
+	if len(tree.root.children) != 1 || tree.root.children[0].label != "test" {
+		t.Errorf("root has %d children after DeletePrefix(\"to\")", len(tree.root.children))
+	}
+}
@@ -1,12 +1,15 @@
 package rbt
 
-func (tree *Tree[K, V]) fixInsert(node *Node[K, V]) {
+// fixInsert restores the properties after inserting the node
+// and reports if the black height of the tree has grown.
+func (tree *Tree[K, V]) fixInsert(node *Node[K, V]) bool {
 	if node.parent == nil {
+		grown := node.color == red
 		node.color = black
 
-		return
+		return grown
 	} else if node.parent.color == black {
-		return
+		return false
 	}
 
 	uncle := node.parent.Sibling()
@@ -14,9 +17,8 @@ func (tree *Tree[K, V]) fixInsert(node *Node[K, V]) {
 		node.parent.color = black
 		uncle.color = black
 		node.parent.parent.color = red
-		tree.fixInsert(node.parent.parent)
 
-		return
+		return tree.fixInsert(node.parent.parent)
 	}
 
 	grandparent := node.parent.parent
@@ -36,6 +38,8 @@ func (tree *Tree[K, V]) fixInsert(node *Node[K, V]) {
 	} else {
 		tree.rotateLeft(grandparent)
 	}
+
+	return false
 }
 
 func (tree *Tree[K, V]) fixRemove(node *Node[K, V]) {
@@ -118,6 +122,9 @@ func (tree *Tree[K, V]) rotateLeft(node *Node[K, V]) {
 
 	rightNode.leftChild = node
 	node.parent = rightNode
+
+	rightNode.size = node.size
+	node.updateSize()
 }
 
 func (tree *Tree[K, V]) rotateRight(node *Node[K, V]) {
@@ -137,4 +144,51 @@ func (tree *Tree[K, V]) rotateRight(node *Node[K, V]) {
 	}
 	leftNode.rightChild = node
 	node.parent = leftNode
+
+	leftNode.size = node.size
+	node.updateSize()
+}
+
+// swapWithSuccessor exchanges the positions of the node
+// and its in-order successor, leaving the node
+// in a position with at most one child.
+// Nodes themselves are moved, so external
+// references to them remain valid.
+func (tree *Tree[K, V]) swapWithSuccessor(node *Node[K, V]) {
+	next := node.rightChild.Min()
+
+	node.color, next.color = next.color, node.color
+	node.size, next.size = next.size, node.size
+
+	parent, leftChild, rightChild := node.parent, node.leftChild, node.rightChild
+	nextParent, nextRightChild := next.parent, next.rightChild
+
+	if parent == nil {
+		tree.root = next
+	} else if parent.leftChild == node {
+		parent.leftChild = next
+	} else {
+		parent.rightChild = next
+	}
+	next.parent = parent
+
+	next.leftChild = leftChild
+	leftChild.parent = next
+
+	if nextParent == node {
+		next.rightChild = node
+		node.parent = next
+	} else {
+		next.rightChild = rightChild
+		rightChild.parent = next
+
+		nextParent.leftChild = node
+		node.parent = nextParent
+	}
+
+	node.leftChild = nil
+	node.rightChild = nextRightChild
+	if nextRightChild != nil {
+		nextRightChild.parent = node
+	}
 }
@@ -0,0 +1,70 @@
+package rbt
+
+import (
+	"bytes"
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"io"
+)
+
+const binaryKind = "rbt.Tree"
+
+// EncodeBinary writes the Tree to the writer in the serial format,
+// entry by entry in the order of the keys.
+func (tree *Tree[K, V]) EncodeBinary(w io.Writer) error {
+	return serial.EncodeStream2(w, binaryKind, tree.nodes, tree.Stream2)
+}
+
+// DecodeBinary replaces the entries of the Tree
+// with the ones read from the reader in the serial format.
+// The Tree is built in linear time, without any rebalancing.
+//
+// The Tree must already have a comparator,
+// so it should be created with one of the constructors.
+func (tree *Tree[K, V]) DecodeBinary(r io.Reader) error {
+	if tree.comparator == nil {
+		return errors.New("rbt: cannot decode into a tree without a comparator")
+	}
+
+	var entries []misc.Pair[K, V]
+	var unsorted bool
+	err := serial.DecodeStream2(r, binaryKind, func(key K, value V) {
+		if len(entries) > 0 && tree.comparator(entries[len(entries)-1].First, key) != comparison.FirstSmaller {
+			unsorted = true
+		}
+
+		entries = append(entries, misc.MakePair(key, value))
+	})
+	if err != nil {
+		return err
+	}
+
+	if unsorted {
+		return errors.New("rbt: decoded keys are not sorted")
+	}
+
+	tree.root = buildFromSorted(entries)
+	tree.nodes = len(entries)
+
+	return nil
+}
+
+// MarshalBinary encodes the Tree in the serial format.
+func (tree *Tree[K, V]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := tree.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Tree from the serial format.
+func (tree *Tree[K, V]) UnmarshalBinary(data []byte) error {
+	return tree.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Tree in the serial format.
+func (tree *Tree[K, V]) GobEncode() ([]byte, error) { return tree.MarshalBinary() }
+
+// GobDecode decodes the Tree from the serial format.
+func (tree *Tree[K, V]) GobDecode(data []byte) error { return tree.UnmarshalBinary(data) }
@@ -0,0 +1,39 @@
+package rbt
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	tree := New[int, string]()
+	for i := range 100 {
+		tree.Set((i*37)%101, "value")
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(tree); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := New[int, string]()
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Size() != tree.Size() {
+		t.Fatalf("Size() = %d, expected %d", decoded.Size(), tree.Size())
+	}
+
+	for i := range tree.Size() {
+		if decoded.Select(i).First != tree.Select(i).First {
+			t.Errorf("Select(%d) = %v, expected %v", i, decoded.Select(i), tree.Select(i))
+		}
+	}
+
+	decoded.Set(1000, "new")
+	if entry, _ := decoded.Last(); entry.First != 1000 {
+		t.Errorf("Last() = %v after insertion", entry)
+	}
+}
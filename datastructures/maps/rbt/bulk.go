@@ -0,0 +1,275 @@
+package rbt
+
+import (
+	"cmp"
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+	"math/bits"
+)
+
+// BuildSortedWithComparator creates a Tree with the specified comparator
+// from the specified iter.Iterable whose entries are sorted by their keys.
+// The Tree is built in linear time, without any rebalancing.
+// The entries are first collected into a slice,
+// so O(n) extra memory is used while building.
+//
+// Panic occurs if the keys are not strictly increasing.
+func BuildSortedWithComparator[K, V any](comparator comparison.Comparator[K], iterable iter.Iterable[misc.Pair[K, V]]) *Tree[K, V] {
+	var entries []misc.Pair[K, V]
+	if finiteIter, ok := any(iterable).(iter.FiniteIterable[misc.Pair[K, V]]); ok {
+		entries = make([]misc.Pair[K, V], 0, finiteIter.Size())
+	}
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		if len(entries) > 0 && comparator(entries[len(entries)-1].First, entry.First) != comparison.FirstSmaller {
+			panic("keys are not sorted")
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return &Tree[K, V]{
+		root:       buildFromSorted(entries),
+		nodes:      len(entries),
+		comparator: comparator,
+	}
+}
+
+// BuildSorted creates a Tree from the specified iter.Iterable
+// whose entries are sorted by their keys.
+// The Tree is built in linear time, without any rebalancing.
+// The entries are first collected into a slice,
+// so O(n) extra memory is used while building.
+//
+// Panic occurs if the keys are not strictly increasing.
+func BuildSorted[K cmp.Ordered, V any](iterable iter.Iterable[misc.Pair[K, V]]) *Tree[K, V] {
+	return BuildSortedWithComparator[K, V](cmp.Compare[K], iterable)
+}
+
+// buildFromSorted builds a balanced subtree from the sorted entries.
+// Only the nodes on the deepest level are red,
+// so every path has the same number of black nodes.
+func buildFromSorted[K, V any](entries []misc.Pair[K, V]) *Node[K, V] {
+	redDepth := bits.Len(uint(len(entries)+1)) - 1
+
+	return buildSubtree(entries, nil, 0, redDepth)
+}
+
+func buildSubtree[K, V any](entries []misc.Pair[K, V], parent *Node[K, V], depth, redDepth int) *Node[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	middle := len(entries) / 2
+	node := &Node[K, V]{
+		key:    entries[middle].First,
+		Value:  entries[middle].Second,
+		color:  black,
+		size:   len(entries),
+		parent: parent,
+	}
+
+	if depth >= redDepth {
+		node.color = red
+	}
+
+	node.leftChild = buildSubtree(entries[:middle], node, depth+1, redDepth)
+	node.rightChild = buildSubtree(entries[middle+1:], node, depth+1, redDepth)
+
+	return node
+}
+
+func blackHeight[K, V any](node *Node[K, V]) int {
+	height := 0
+	for curr := node; curr != nil; curr = curr.leftChild {
+		if curr.color == black {
+			height++
+		}
+	}
+
+	return height
+}
+
+func detachRoot[K, V any](node *Node[K, V]) *Node[K, V] {
+	if node != nil {
+		node.parent = nil
+		node.color = black
+	}
+
+	return node
+}
+
+// childHeight returns the black height of the child of a node
+// with the specified black height once the child is detached.
+func childHeight[K, V any](child *Node[K, V], height int) int {
+	if nodeColor(child) == red {
+		return height
+	}
+
+	return height - 1
+}
+
+// join links the left subtree, the middle node and the right subtree
+// into a single subtree and returns its root and its black height.
+// All keys in the left subtree must be smaller than the key of the middle node,
+// and all keys in the right subtree must be bigger than it.
+//
+// The black heights of the subtrees are passed in, counting their roots
+// as black, so join takes time proportional to their difference.
+func (tree *Tree[K, V]) join(left *Node[K, V], leftHeight int, middle *Node[K, V], right *Node[K, V], rightHeight int) (*Node[K, V], int) {
+	left, right = detachRoot(left), detachRoot(right)
+
+	middle.color = red
+	middle.parent = nil
+
+	if leftHeight == rightHeight {
+		middle.leftChild, middle.rightChild = left, right
+		if left != nil {
+			left.parent = middle
+		}
+		if right != nil {
+			right.parent = middle
+		}
+
+		middle.color = black
+		middle.updateSize()
+
+		return middle, leftHeight + 1
+	}
+
+	joined := &Tree[K, V]{comparator: tree.comparator}
+
+	var parent *Node[K, V]
+	if leftHeight > rightHeight {
+		joined.root = left
+
+		curr, height := left, leftHeight
+		for nodeColor(curr) == red || height != rightHeight {
+			if nodeColor(curr) == black {
+				height--
+			}
+
+			parent, curr = curr, curr.rightChild
+		}
+
+		middle.leftChild, middle.rightChild = curr, right
+		parent.rightChild = middle
+	} else {
+		joined.root = right
+
+		curr, height := right, rightHeight
+		for nodeColor(curr) == red || height != leftHeight {
+			if nodeColor(curr) == black {
+				height--
+			}
+
+			parent, curr = curr, curr.leftChild
+		}
+
+		middle.leftChild, middle.rightChild = left, curr
+		parent.leftChild = middle
+	}
+
+	middle.parent = parent
+	if middle.leftChild != nil {
+		middle.leftChild.parent = middle
+	}
+	if middle.rightChild != nil {
+		middle.rightChild.parent = middle
+	}
+
+	middle.updateSize()
+	for ancestor := parent; ancestor != nil; ancestor = ancestor.parent {
+		ancestor.updateSize()
+	}
+
+	height := max(leftHeight, rightHeight)
+	if joined.fixInsert(middle) {
+		height++
+	}
+
+	return joined.root, height
+}
+
+// split splits the subtree with the specified black height,
+// counting its root as black, into the nodes with keys smaller
+// than the specified key and the rest. Returns the roots
+// of both parts together with their black heights.
+func (tree *Tree[K, V]) split(node *Node[K, V], height int, key K) (*Node[K, V], int, *Node[K, V], int) {
+	if node == nil {
+		return nil, 0, nil, 0
+	}
+
+	left, right := node.leftChild, node.rightChild
+	node.leftChild, node.rightChild = nil, nil
+
+	leftHeight, rightHeight := childHeight(left, height), childHeight(right, height)
+
+	if tree.comparator(key, node.key) == comparison.FirstBigger {
+		lower, lowerHeight, upper, upperHeight := tree.split(right, rightHeight, key)
+		lower, lowerHeight = tree.join(left, leftHeight, node, lower, lowerHeight)
+
+		return lower, lowerHeight, upper, upperHeight
+	} else {
+		lower, lowerHeight, upper, upperHeight := tree.split(left, leftHeight, key)
+		upper, upperHeight = tree.join(upper, upperHeight, node, right, rightHeight)
+
+		return lower, lowerHeight, upper, upperHeight
+	}
+}
+
+// Split moves the entries of the Tree into two new Trees.
+// The first one contains the entries with keys smaller than
+// the specified key, and the second one contains the rest.
+// The Tree becomes empty.
+//
+// Split takes O(log n) time.
+func (tree *Tree[K, V]) Split(key K) (*Tree[K, V], *Tree[K, V]) {
+	lower, _, upper, _ := tree.split(tree.root, blackHeight(tree.root), key)
+
+	tree.root = nil
+	tree.nodes = 0
+
+	return &Tree[K, V]{root: detachRoot(lower), nodes: nodeSize(lower), comparator: tree.comparator},
+		&Tree[K, V]{root: detachRoot(upper), nodes: nodeSize(upper), comparator: tree.comparator}
+}
+
+// Join moves all entries from the other Tree into the Tree.
+// The other Tree becomes empty.
+// All keys of one Tree must be smaller than all keys of the other.
+//
+// Join takes O(log n) time.
+// Panic occurs if the ranges of the keys in the Trees overlap.
+func (tree *Tree[K, V]) Join(other *Tree[K, V]) {
+	if other.root == nil {
+		return
+	}
+
+	if tree.root == nil {
+		tree.root, tree.nodes = other.root, other.nodes
+		other.Clear()
+		return
+	}
+
+	var lower, upper *Tree[K, V]
+	switch {
+	case tree.comparator(tree.LastNode().key, other.FirstNode().key) == comparison.FirstSmaller:
+		lower, upper = tree, other
+	case tree.comparator(other.LastNode().key, tree.FirstNode().key) == comparison.FirstSmaller:
+		lower, upper = other, tree
+	default:
+		panic("key ranges of the trees overlap")
+	}
+
+	nodes := tree.nodes + other.nodes
+
+	middle := upper.FirstNode()
+	upper.removeNode(middle)
+	joined, _ := tree.join(lower.root, blackHeight(lower.root), middle, upper.root, blackHeight(upper.root))
+	tree.root = detachRoot(joined)
+	tree.nodes = nodes
+
+	other.Clear()
+}
@@ -0,0 +1,90 @@
+package rbt
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/array"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"testing"
+)
+
+func TestSplitJoin(t *testing.T) {
+	entries := array.New[misc.Pair[int, int]]()
+	for i := range 1000 {
+		entries.Append(misc.MakePair(i, i))
+	}
+
+	tree := BuildSorted[int, int](entries)
+	if tree.Size() != 1000 || tree.Select(500).First != 500 {
+		t.Fatalf("unexpected tree of size %d", tree.Size())
+	}
+
+	lower, upper := tree.Split(300)
+	if lower.Size() != 300 || upper.Size() != 700 {
+		t.Errorf("Split(300) sizes = %d, %d", lower.Size(), upper.Size())
+	}
+	if entry, _ := lower.Last(); entry.First != 299 {
+		t.Errorf("lower.Last() = %v", entry)
+	}
+	if entry, _ := upper.First(); entry.First != 300 {
+		t.Errorf("upper.First() = %v", entry)
+	}
+
+	upper.Join(lower)
+	if upper.Size() != 1000 || lower.Size() != 0 {
+		t.Errorf("Join sizes = %d, %d", upper.Size(), lower.Size())
+	}
+
+	prev := -1
+	for key := range upper.Keys {
+		if key != prev+1 {
+			t.Fatalf("unexpected key %d after %d", key, prev)
+		}
+		prev = key
+	}
+}
+
+func checkBalanced(t *testing.T, node *Node[int, int]) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+
+	if node.color == red && (nodeColor(node.leftChild) == red || nodeColor(node.rightChild) == red) {
+		t.Fatalf("red node %d has a red child", node.key)
+	}
+
+	leftHeight, rightHeight := checkBalanced(t, node.leftChild), checkBalanced(t, node.rightChild)
+	if leftHeight != rightHeight {
+		t.Fatalf("node %d has children with black heights %d and %d", node.key, leftHeight, rightHeight)
+	}
+
+	if node.size != 1+nodeSize(node.leftChild)+nodeSize(node.rightChild) {
+		t.Fatalf("node %d has size %d", node.key, node.size)
+	}
+
+	if node.color == black {
+		leftHeight++
+	}
+
+	return leftHeight
+}
+
+func TestSplitKeepsBalance(t *testing.T) {
+	for _, key := range []int{-1, 0, 1, 137, 500, 998, 999, 1000} {
+		tree := New[int, int]()
+		for i := range 1000 {
+			tree.Set((i*389)%1000, i)
+		}
+
+		lower, upper := tree.Split(key)
+		checkBalanced(t, lower.root)
+		checkBalanced(t, upper.root)
+
+		if lower.Size() != max(0, min(key, 1000)) || lower.Size()+upper.Size() != 1000 {
+			t.Errorf("Split(%d) sizes = %d, %d", key, lower.Size(), upper.Size())
+		}
+
+		lower.Join(upper)
+		checkBalanced(t, lower.root)
+	}
+}
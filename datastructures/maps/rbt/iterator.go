@@ -1,6 +1,9 @@
 package rbt
 
-import "github.com/djordje200179/extendedlibrary/misc"
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/misc"
+)
 
 // Iterator is an iterator over a Tree.
 type Iterator[K, V any] struct {
@@ -23,6 +26,54 @@ func (it *Iterator[K, V]) Move() {
 	it.curr = it.curr.Next()
 }
 
+// MoveBack moves the iterator to the previous entry.
+func (it *Iterator[K, V]) MoveBack() {
+	if it.curr == nil {
+		return
+	}
+
+	it.curr = it.curr.Prev()
+}
+
+// SeekFirst moves the iterator to the entry with the smallest key.
+func (it *Iterator[K, V]) SeekFirst() {
+	it.curr = it.tree.FirstNode()
+}
+
+// SeekLast moves the iterator to the entry with the greatest key.
+func (it *Iterator[K, V]) SeekLast() {
+	it.curr = it.tree.LastNode()
+}
+
+// Seek moves the iterator to the entry
+// at the specified position in the order of the keys.
+// Seeking out of bounds makes the iterator invalid.
+func (it *Iterator[K, V]) Seek(index int) {
+	if index < 0 || index >= it.tree.nodes {
+		it.curr = nil
+		return
+	}
+
+	it.curr = it.tree.SelectNode(index)
+}
+
+// Index returns the position of the current entry
+// in the order of the keys, or the size of the Tree
+// if the iterator is not valid.
+func (it *Iterator[K, V]) Index() int {
+	if it.curr == nil {
+		return it.tree.nodes
+	}
+
+	return it.curr.Index()
+}
+
+// Distance returns the number of moves needed
+// to get from the current entry to the entry of the other iterator.
+func (it *Iterator[K, V]) Distance(other iter.RandomAccessIterator[misc.Pair[K, V]]) int {
+	return other.Index() - it.Index()
+}
+
 // Get returns the current entry as a key-value pair.
 func (it *Iterator[K, V]) Get() misc.Pair[K, V] {
 	return misc.MakePair(it.Key(), it.Value())
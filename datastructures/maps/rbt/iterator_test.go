@@ -0,0 +1,147 @@
+package rbt
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/itertest"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	tree := New[int, int]()
+	var keys, values []int
+	var entries []misc.Pair[int, int]
+	for i := range 10 {
+		tree.Set(i*10, i)
+		keys = append(keys, i*10)
+		values = append(values, i)
+		entries = append(entries, misc.MakePair(i*10, i))
+	}
+
+	itertest.CheckBidirectional[misc.Pair[int, int]](t, tree.MapIterator().(*Iterator[int, int]), entries)
+	itertest.CheckBidirectional[misc.Pair[int, int]](t, New[int, int]().MapIterator().(*Iterator[int, int]), nil)
+	itertest.CheckReverseStream2(t, tree.ReverseStream2, keys, values)
+
+	view := tree.SubMap(20, 70)
+	itertest.CheckBidirectional[misc.Pair[int, int]](t, view.MapIterator().(*ViewIterator[int, int]), entries[2:7])
+	itertest.CheckReverseStream2(t, view.ReverseStream2, keys[2:7], values[2:7])
+}
+
+func newTensTree() *Tree[int, int] {
+	tree := New[int, int]()
+	for i := range 10 {
+		tree.Set(i*10, i)
+	}
+
+	return tree
+}
+
+func TestIteratorSeek(t *testing.T) {
+	tree := newTensTree()
+
+	it := tree.MapIterator().(*Iterator[int, int])
+	other := tree.MapIterator().(*Iterator[int, int])
+
+	it.Seek(3)
+	other.Seek(7)
+	if it.Key() != 30 || it.Index() != 3 {
+		t.Errorf("Seek(3) points to key %d at index %d", it.Key(), it.Index())
+	}
+
+	if it.Distance(other) != 4 || other.Distance(it) != -4 {
+		t.Errorf("Distance() = %d, %d", it.Distance(other), other.Distance(it))
+	}
+
+	other.Seek(10)
+	if other.Valid() || other.Index() != tree.Size() || it.Distance(other) != 7 {
+		t.Errorf("invalid iterator has index %d", other.Index())
+	}
+
+	other.Seek(-1)
+	if other.Valid() || other.Index() != tree.Size() {
+		t.Errorf("Seek(-1) left index %d", other.Index())
+	}
+}
+
+func TestSeekTo(t *testing.T) {
+	tree := newTensTree()
+
+	tests := []struct {
+		key, expected int
+	}{
+		{-5, 0},
+		{0, 0},
+		{35, 40},
+		{40, 40},
+		{89, 90},
+	}
+
+	for _, test := range tests {
+		it := tree.SeekTo(test.key)
+		if !it.Valid() || it.Get().First != test.expected {
+			t.Errorf("SeekTo(%d) is not at %d", test.key, test.expected)
+		}
+	}
+
+	it := tree.SeekTo(35)
+	it.MoveBack()
+	if it.Get().First != 30 {
+		t.Errorf("MoveBack() after SeekTo(35) is at %d", it.Get().First)
+	}
+
+	if it := tree.SeekTo(95); it.Valid() {
+		t.Errorf("SeekTo(95) is at %d", it.Get().First)
+	}
+}
+
+func TestViewIteratorSeek(t *testing.T) {
+	tree := newTensTree()
+
+	tests := []struct {
+		lower, upper bound[int]
+		expected     []int
+	}{
+		{bound[int]{20, true, true}, bound[int]{70, false, true}, []int{20, 30, 40, 50, 60}},
+		{bound[int]{20, false, true}, bound[int]{70, true, true}, []int{30, 40, 50, 60, 70}},
+		{bound[int]{25, true, true}, bound[int]{65, true, true}, []int{30, 40, 50, 60}},
+		{bound[int]{}, bound[int]{30, true, true}, []int{0, 10, 20, 30}},
+		{bound[int]{85, false, true}, bound[int]{}, []int{90}},
+		{bound[int]{50, false, true}, bound[int]{50, true, true}, nil},
+		{bound[int]{50, true, true}, bound[int]{50, false, true}, nil},
+	}
+
+	for _, test := range tests {
+		view := &View[int, int]{tree, test.lower, test.upper}
+		if view.Size() != len(test.expected) {
+			t.Errorf("view %v..%v has size %d", test.lower, test.upper, view.Size())
+			continue
+		}
+
+		it := view.MapIterator().(*ViewIterator[int, int])
+		for i, key := range test.expected {
+			it.Seek(i)
+			if !it.Valid() || it.Key() != key || it.Index() != i {
+				t.Errorf("view %v..%v: Seek(%d) is at index %d", test.lower, test.upper, i, it.Index())
+			}
+		}
+
+		for _, index := range []int{-1, len(test.expected)} {
+			it.Seek(index)
+			if it.Valid() || it.Index() != view.Size() {
+				t.Errorf("view %v..%v: Seek(%d) left index %d", test.lower, test.upper, index, it.Index())
+			}
+		}
+
+		it.SeekLast()
+		it.Move()
+		if it.Valid() || it.Index() != view.Size() {
+			t.Errorf("view %v..%v: iterator past the end has index %d", test.lower, test.upper, it.Index())
+		}
+
+		if len(test.expected) > 0 {
+			first := view.MapIterator().(*ViewIterator[int, int])
+			if distance := first.Distance(it); distance != len(test.expected) {
+				t.Errorf("view %v..%v: Distance() to the end = %d", test.lower, test.upper, distance)
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package rbt
+
+import (
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/internal/jsonmap"
+)
+
+// MarshalJSON encodes the Tree as a JSON object
+// whose members are in the order of the keys.
+//
+// Keys must be strings, integers or implement encoding.TextMarshaler.
+func (tree *Tree[K, V]) MarshalJSON() ([]byte, error) {
+	return jsonmap.Marshal(tree.Stream2)
+}
+
+// UnmarshalJSON replaces the entries of the Tree
+// with the members of the JSON object.
+//
+// Keys must be strings, integers or implement encoding.TextUnmarshaler.
+// The Tree must already have a comparator,
+// so it should be created with one of the constructors.
+func (tree *Tree[K, V]) UnmarshalJSON(data []byte) error {
+	if tree.comparator == nil {
+		return errors.New("rbt: cannot unmarshal into a tree without a comparator")
+	}
+
+	entries, err := jsonmap.Unmarshal[K, V](data)
+	if err != nil {
+		return err
+	}
+
+	tree.Clear()
+	for _, entry := range entries {
+		tree.Set(entry.First, entry.Second)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package rbt
+
+import (
+	"encoding/json"
+	"net/netip"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	tree := New[int, string]()
+	for _, key := range []int{3, 1, 2} {
+		tree.Set(key, "v")
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != `{"1":"v","2":"v","3":"v"}` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := New[int, string]()
+	decoded.Set(10, "old")
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if keys := slices.Collect(decoded.Keys); !slices.Equal(keys, []int{1, 2, 3}) {
+		t.Errorf("decoded keys are %v", keys)
+	}
+
+	if err := json.Unmarshal(data, new(Tree[int, string])); err == nil {
+		t.Error("Unmarshal() into a tree without a comparator succeeded")
+	}
+}
+
+func TestJSONTextMarshalerKeys(t *testing.T) {
+	tree := NewWithComparator[netip.Addr, int](netip.Addr.Compare)
+	tree.Set(netip.MustParseAddr("10.0.0.2"), 2)
+	tree.Set(netip.MustParseAddr("10.0.0.1"), 1)
+
+	data, err := json.Marshal(tree)
+	if err != nil || string(data) != `{"10.0.0.1":1,"10.0.0.2":2}` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := NewWithComparator[netip.Addr, int](netip.Addr.Compare)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Size() != 2 || decoded.Get(netip.MustParseAddr("10.0.0.2")) != 2 {
+		t.Errorf("decoded tree has size %d", decoded.Size())
+	}
+
+	if err := json.Unmarshal([]byte(`{"not an address":1}`), decoded); err == nil {
+		t.Error("Unmarshal() of an invalid key succeeded")
+	}
+}
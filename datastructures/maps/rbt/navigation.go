@@ -0,0 +1,166 @@
+package rbt
+
+import (
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+)
+
+// FloorNode returns the node with the greatest key
+// less than or equal to the specified key.
+// Returns nil if there is no such node.
+func (tree *Tree[K, V]) FloorNode(key K) *Node[K, V] {
+	var candidate *Node[K, V]
+	for curr := tree.root; curr != nil; {
+		switch tree.comparator(key, curr.key) {
+		case comparison.FirstSmaller:
+			curr = curr.leftChild
+		case comparison.FirstBigger:
+			candidate = curr
+			curr = curr.rightChild
+		case comparison.Equal:
+			return curr
+		}
+	}
+
+	return candidate
+}
+
+// CeilingNode returns the node with the smallest key
+// greater than or equal to the specified key.
+// Returns nil if there is no such node.
+func (tree *Tree[K, V]) CeilingNode(key K) *Node[K, V] {
+	var candidate *Node[K, V]
+	for curr := tree.root; curr != nil; {
+		switch tree.comparator(key, curr.key) {
+		case comparison.FirstSmaller:
+			candidate = curr
+			curr = curr.leftChild
+		case comparison.FirstBigger:
+			curr = curr.rightChild
+		case comparison.Equal:
+			return curr
+		}
+	}
+
+	return candidate
+}
+
+// LowerNode returns the node with the greatest key
+// strictly less than the specified key.
+// Returns nil if there is no such node.
+func (tree *Tree[K, V]) LowerNode(key K) *Node[K, V] {
+	var candidate *Node[K, V]
+	for curr := tree.root; curr != nil; {
+		if tree.comparator(key, curr.key) == comparison.FirstBigger {
+			candidate = curr
+			curr = curr.rightChild
+		} else {
+			curr = curr.leftChild
+		}
+	}
+
+	return candidate
+}
+
+// HigherNode returns the node with the smallest key
+// strictly greater than the specified key.
+// Returns nil if there is no such node.
+func (tree *Tree[K, V]) HigherNode(key K) *Node[K, V] {
+	var candidate *Node[K, V]
+	for curr := tree.root; curr != nil; {
+		if tree.comparator(key, curr.key) == comparison.FirstSmaller {
+			candidate = curr
+			curr = curr.leftChild
+		} else {
+			curr = curr.rightChild
+		}
+	}
+
+	return candidate
+}
+
+// FirstNode returns the node with the smallest key.
+// Returns nil if the tree is empty.
+func (tree *Tree[K, V]) FirstNode() *Node[K, V] {
+	return tree.root.Min()
+}
+
+// LastNode returns the node with the greatest key.
+// Returns nil if the tree is empty.
+func (tree *Tree[K, V]) LastNode() *Node[K, V] {
+	return tree.root.Max()
+}
+
+func nodeEntry[K, V any](node *Node[K, V]) (misc.Pair[K, V], bool) {
+	if node == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	return misc.MakePair(node.key, node.Value), true
+}
+
+// Floor returns the entry with the greatest key
+// less than or equal to the specified key.
+// Returns false if there is no such entry.
+func (tree *Tree[K, V]) Floor(key K) (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.FloorNode(key))
+}
+
+// Ceiling returns the entry with the smallest key
+// greater than or equal to the specified key.
+// Returns false if there is no such entry.
+func (tree *Tree[K, V]) Ceiling(key K) (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.CeilingNode(key))
+}
+
+// Lower returns the entry with the greatest key
+// strictly less than the specified key.
+// Returns false if there is no such entry.
+func (tree *Tree[K, V]) Lower(key K) (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.LowerNode(key))
+}
+
+// Higher returns the entry with the smallest key
+// strictly greater than the specified key.
+// Returns false if there is no such entry.
+func (tree *Tree[K, V]) Higher(key K) (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.HigherNode(key))
+}
+
+// First returns the entry with the smallest key.
+// Returns false if the tree is empty.
+func (tree *Tree[K, V]) First() (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.FirstNode())
+}
+
+// Last returns the entry with the greatest key.
+// Returns false if the tree is empty.
+func (tree *Tree[K, V]) Last() (misc.Pair[K, V], bool) {
+	return nodeEntry(tree.LastNode())
+}
+
+// PollFirst removes and returns the entry with the smallest key.
+// Returns false if the tree is empty.
+func (tree *Tree[K, V]) PollFirst() (misc.Pair[K, V], bool) {
+	node := tree.FirstNode()
+	if node == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	tree.removeNode(node)
+
+	return misc.MakePair(node.key, node.Value), true
+}
+
+// PollLast removes and returns the entry with the greatest key.
+// Returns false if the tree is empty.
+func (tree *Tree[K, V]) PollLast() (misc.Pair[K, V], bool) {
+	node := tree.LastNode()
+	if node == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	tree.removeNode(node)
+
+	return misc.MakePair(node.key, node.Value), true
+}
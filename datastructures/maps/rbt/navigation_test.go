@@ -0,0 +1,46 @@
+package rbt
+
+import "testing"
+
+func TestNavigation(t *testing.T) {
+	tree := New[int, string]()
+	for _, key := range []int{10, 20, 30, 40} {
+		tree.Set(key, "")
+	}
+
+	if entry, ok := tree.Floor(25); !ok || entry.First != 20 {
+		t.Errorf("Floor(25) = %v, %v", entry, ok)
+	}
+	if entry, ok := tree.Ceiling(25); !ok || entry.First != 30 {
+		t.Errorf("Ceiling(25) = %v, %v", entry, ok)
+	}
+	if entry, ok := tree.Lower(10); ok {
+		t.Errorf("Lower(10) = %v, %v", entry, ok)
+	}
+	if entry, ok := tree.Higher(30); !ok || entry.First != 40 {
+		t.Errorf("Higher(30) = %v, %v", entry, ok)
+	}
+
+	if entry, ok := tree.PollFirst(); !ok || entry.First != 10 {
+		t.Errorf("PollFirst() = %v, %v", entry, ok)
+	}
+	if entry, ok := tree.PollLast(); !ok || entry.First != 40 {
+		t.Errorf("PollLast() = %v, %v", entry, ok)
+	}
+	if tree.Size() != 2 {
+		t.Errorf("Size() = %d", tree.Size())
+	}
+}
+
+func TestRemoveInnerNode(t *testing.T) {
+	tree := New[int, int]()
+	for i := range 10 {
+		tree.Set(i, i)
+	}
+
+	tree.Remove(3)
+
+	if tree.Contains(3) || !tree.Contains(4) {
+		t.Error("wrong entry removed")
+	}
+}
@@ -14,6 +14,7 @@ type Node[K, V any] struct {
 	Value V // Value is the value stored in the node.
 
 	color color
+	size  int
 
 	leftChild  *Node[K, V]
 	rightChild *Node[K, V]
@@ -61,7 +62,7 @@ func (node *Node[K, V]) Prev() *Node[K, V] {
 		return node.leftChild.Max()
 	}
 
-	for prev, curr := node.parent, node; prev != nil && curr == prev.leftChild; curr, prev = prev, prev.parent {
+	for prev, curr := node.parent, node; prev != nil; curr, prev = prev, prev.parent {
 		if curr != prev.leftChild {
 			return prev
 		}
@@ -109,14 +110,27 @@ func (node *Node[K, V]) Max() *Node[K, V] {
 }
 
 // Clone returns a clone of the node.
-// The clone has the same key, value, and color as the node.
+// The clone has the same key, value, color and subtree size as the node.
 // The clone does not have any links to other nodes.
 func (node *Node[K, V]) Clone() *Node[K, V] {
 	return &Node[K, V]{
 		key:   node.key,
 		Value: node.Value,
 		color: node.color,
+		size:  node.size,
+	}
+}
+
+func nodeSize[K, V any](node *Node[K, V]) int {
+	if node == nil {
+		return 0
 	}
+
+	return node.size
+}
+
+func (node *Node[K, V]) updateSize() {
+	node.size = nodeSize(node.leftChild) + nodeSize(node.rightChild) + 1
 }
 
 func nodeColor[K, V any](node *Node[K, V]) color {
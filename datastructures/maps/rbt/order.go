@@ -0,0 +1,86 @@
+package rbt
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/cols"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+)
+
+func (tree *Tree[K, V]) countBelow(key K, inclusive bool) int {
+	count := 0
+	for curr := tree.root; curr != nil; {
+		result := tree.comparator(key, curr.key)
+		if result == comparison.FirstBigger || (result == comparison.Equal && inclusive) {
+			count += nodeSize(curr.leftChild) + 1
+			curr = curr.rightChild
+		} else {
+			curr = curr.leftChild
+		}
+	}
+
+	return count
+}
+
+// Rank returns the number of keys strictly less than the specified key.
+// If the key is present, this is its zero-based position in the order of the keys.
+func (tree *Tree[K, V]) Rank(key K) int {
+	return tree.countBelow(key, false)
+}
+
+// CountRange returns the number of keys in the range [lower, upper).
+func (tree *Tree[K, V]) CountRange(lower, upper K) int {
+	return max(tree.Rank(upper)-tree.Rank(lower), 0)
+}
+
+// SelectNode returns the node at the specified position
+// in the order of the keys.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (tree *Tree[K, V]) SelectNode(index int) *Node[K, V] {
+	if index >= tree.nodes || index < -tree.nodes {
+		panic(cols.IndexOutOfBoundsError{Index: index, Length: tree.nodes})
+	}
+
+	if index < 0 {
+		index += tree.nodes
+	}
+
+	curr := tree.root
+	for {
+		leftSize := nodeSize(curr.leftChild)
+
+		switch {
+		case index < leftSize:
+			curr = curr.leftChild
+		case index > leftSize:
+			index -= leftSize + 1
+			curr = curr.rightChild
+		default:
+			return curr
+		}
+	}
+}
+
+// Select returns the entry at the specified position
+// in the order of the keys.
+//
+// Negative indices are interpreted as relative to the end.
+// Panic occurs if the index is out of bounds.
+func (tree *Tree[K, V]) Select(index int) misc.Pair[K, V] {
+	node := tree.SelectNode(index)
+	return misc.MakePair(node.key, node.Value)
+}
+
+// Index returns the position of the node
+// in the order of the keys of its tree.
+func (node *Node[K, V]) Index() int {
+	index := nodeSize(node.leftChild)
+	for curr := node; curr.parent != nil; curr = curr.parent {
+		if curr == curr.parent.rightChild {
+			index += nodeSize(curr.parent.leftChild) + 1
+		}
+	}
+
+	return index
+}
@@ -0,0 +1,116 @@
+package rbt
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestOrderStatistics(t *testing.T) {
+	tree := New[int, int]()
+	for i := range 100 {
+		tree.Set(i*2, i)
+	}
+	tree.Remove(50)
+
+	if rank := tree.Rank(60); rank != 29 {
+		t.Errorf("Rank(60) = %d", rank)
+	}
+	if entry := tree.Select(29); entry.First != 60 {
+		t.Errorf("Select(29) = %v", entry)
+	}
+	if entry := tree.Select(-1); entry.First != 198 {
+		t.Errorf("Select(-1) = %v", entry)
+	}
+	if count := tree.CountRange(10, 60); count != 24 {
+		t.Errorf("CountRange(10, 60) = %d", count)
+	}
+	if index := tree.GetNode(100).Index(); index != 49 {
+		t.Errorf("Index() = %d", index)
+	}
+}
+
+func checkSizes(t *testing.T, node *Node[int, int]) int {
+	t.Helper()
+
+	if node == nil {
+		return 0
+	}
+
+	size := checkSizes(t, node.leftChild) + checkSizes(t, node.rightChild) + 1
+	if node.size != size {
+		t.Fatalf("node %d has size %d instead of %d", node.key, node.size, size)
+	}
+
+	return size
+}
+
+func checkOrder(t *testing.T, tree *Tree[int, int], expected []int) {
+	t.Helper()
+
+	if size := checkSizes(t, tree.root); size != len(expected) || tree.Size() != len(expected) {
+		t.Fatalf("tree has %d nodes and size %d instead of %d", size, tree.Size(), len(expected))
+	}
+
+	for i, key := range expected {
+		if entry := tree.Select(i); entry.First != key {
+			t.Fatalf("Select(%d) = %v, expected %d", i, entry, key)
+		}
+		if entry := tree.Select(i - len(expected)); entry.First != key {
+			t.Fatalf("Select(%d) = %v, expected %d", i-len(expected), entry, key)
+		}
+		if rank := tree.Rank(key); rank != i {
+			t.Fatalf("Rank(%d) = %d, expected %d", key, rank, i)
+		}
+		if rank := tree.Rank(key + 1); rank != i+1 {
+			t.Fatalf("Rank(%d) = %d, expected %d", key+1, rank, i+1)
+		}
+		if index := tree.GetNode(key).Index(); index != i {
+			t.Fatalf("GetNode(%d).Index() = %d, expected %d", key, index, i)
+		}
+	}
+}
+
+func TestRandomOrderStatistics(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	tree := New[int, int]()
+	var expected []int
+
+	for i := range 3000 {
+		key := random.Intn(500) * 2
+
+		index, found := slices.BinarySearch(expected, key)
+		if random.Intn(3) == 0 {
+			tree.Remove(key)
+			if found {
+				expected = slices.Delete(expected, index, index+1)
+			}
+		} else {
+			tree.Set(key, i)
+			if !found {
+				expected = slices.Insert(expected, index, key)
+			}
+		}
+
+		if i%100 == 0 {
+			checkOrder(t, tree, expected)
+		}
+	}
+
+	checkOrder(t, tree, expected)
+	checkOrder(t, tree.Clone().(*Tree[int, int]), expected)
+
+	for range 20 {
+		key := random.Intn(1000)
+		index, _ := slices.BinarySearch(expected, key)
+
+		lower, upper := tree.Split(key)
+		checkOrder(t, lower, expected[:index])
+		checkOrder(t, upper, expected[index:])
+
+		upper.Join(lower)
+		checkOrder(t, upper, expected)
+		tree = upper
+	}
+}
@@ -117,6 +117,7 @@ func (tree *Tree[K, V]) Set(key K, value V) {
 			key:   key,
 			Value: value,
 			color: black,
+			size:  1,
 		}
 
 		tree.nodes++
@@ -143,6 +144,7 @@ func (tree *Tree[K, V]) Set(key K, value V) {
 		Value:  value,
 		parent: prev,
 		color:  red,
+		size:   1,
 	}
 
 	if tree.comparator(key, prev.key) == comparison.FirstSmaller {
@@ -152,20 +154,16 @@ func (tree *Tree[K, V]) Set(key K, value V) {
 	}
 
 	tree.nodes++
+	for curr := prev; curr != nil; curr = curr.parent {
+		curr.size++
+	}
 
 	tree.fixInsert(node)
 }
 
 func (tree *Tree[K, V]) removeNode(node *Node[K, V]) {
 	if node.leftChild != nil && node.rightChild != nil {
-		next := node.Next()
-
-		next.key = node.key
-		next.Value = node.Value
-
-		tree.removeNode(next)
-
-		return
+		tree.swapWithSuccessor(node)
 	}
 
 	tree.nodes--
@@ -190,12 +188,21 @@ func (tree *Tree[K, V]) removeNode(node *Node[K, V]) {
 		child.parent = node.parent
 		*locationInParent = child
 
+		for curr := node.parent; curr != nil; curr = curr.parent {
+			curr.size--
+		}
+
 		if node.color == black {
 			tree.fixRemove(child)
 		}
 	} else if node.parent == nil {
 		*locationInParent = nil
 	} else {
+		node.size = 0
+		for curr := node.parent; curr != nil; curr = curr.parent {
+			curr.size--
+		}
+
 		if node.color == black {
 			tree.fixRemove(node)
 		}
@@ -224,6 +231,7 @@ func (tree *Tree[K, V]) Remove(key K) {
 // Clear removes all entries from the tree.
 func (tree *Tree[K, V]) Clear() {
 	tree.root = nil
+	tree.nodes = 0
 }
 
 // Clone returns a shallow copy of the tree.
@@ -255,6 +263,7 @@ func (tree *Tree[K, V]) Clone() maps.Map[K, V] {
 			newLeftNode := leftNode.Clone()
 			nodeInCloned.leftChild = newLeftNode
 			newLeftNode.parent = nodeInCloned
+			nodesInCloned.PushBack(newLeftNode)
 		}
 
 		if rightNode := nodeInOriginal.rightChild; rightNode != nil {
@@ -263,6 +272,7 @@ func (tree *Tree[K, V]) Clone() maps.Map[K, V] {
 			newRightNode := rightNode.Clone()
 			nodeInCloned.rightChild = newRightNode
 			newRightNode.parent = nodeInCloned
+			nodesInCloned.PushBack(newRightNode)
 		}
 	}
 
@@ -279,6 +289,12 @@ func (tree *Tree[K, V]) MapIterator() maps.Iterator[K, V] {
 	return &Iterator[K, V]{tree, tree.root.Min()}
 }
 
+// SeekTo returns an iterator at the entry with the smallest key
+// greater than or equal to the specified key.
+func (tree *Tree[K, V]) SeekTo(key K) iter.BidirectionalIterator[misc.Pair[K, V]] {
+	return &Iterator[K, V]{tree, tree.CeilingNode(key)}
+}
+
 // Stream2 streams over the entries in the Tree.
 func (tree *Tree[K, V]) Stream2(yield func(K, V) bool) {
 	for it := tree.MapIterator(); it.Valid(); it.Move() {
@@ -288,6 +304,16 @@ func (tree *Tree[K, V]) Stream2(yield func(K, V) bool) {
 	}
 }
 
+// ReverseStream2 streams over the entries in the Tree
+// in the reverse order of the keys.
+func (tree *Tree[K, V]) ReverseStream2(yield func(K, V) bool) {
+	for node := tree.LastNode(); node != nil; node = node.Prev() {
+		if !yield(node.key, node.Value) {
+			return
+		}
+	}
+}
+
 // Keys streams the keys of the Tree.
 func (tree *Tree[K, V]) Keys(yield func(K) bool) {
 	for it := tree.MapIterator(); it.Valid(); it.Move() {
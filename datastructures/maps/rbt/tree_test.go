@@ -0,0 +1,30 @@
+package rbt
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	tree := New[int, int]()
+	for i := range 50 {
+		tree.Set((i*17)%50, i)
+	}
+
+	cloned := tree.Clone().(*Tree[int, int])
+	if !slices.Equal(slices.Collect(cloned.Keys), slices.Collect(tree.Keys)) {
+		t.Fatalf("cloned keys are %v", slices.Collect(cloned.Keys))
+	}
+
+	cloned.Set(10, -1)
+	cloned.Remove(20)
+	cloned.Set(100, 100)
+
+	if tree.Get(10) == -1 || !tree.Contains(20) || tree.Contains(100) || tree.Size() != 50 {
+		t.Error("modifying the clone changed the original")
+	}
+
+	if cloned.Get(10) != -1 || cloned.Contains(20) || cloned.Size() != 50 {
+		t.Error("clone wasn't modified")
+	}
+}
@@ -0,0 +1,481 @@
+package rbt
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/comparison"
+)
+
+type bound[K any] struct {
+	key       K
+	inclusive bool
+	present   bool
+}
+
+// View is a live view over the entries of a Tree
+// whose keys are in a specific range.
+//
+// It is backed by the original Tree, so changes
+// made through the View are reflected in the Tree and vice versa.
+type View[K, V any] struct {
+	tree *Tree[K, V]
+
+	lower, upper bound[K]
+}
+
+// HeadMap returns a View over the entries
+// with keys strictly less than the specified key.
+func (tree *Tree[K, V]) HeadMap(to K) *View[K, V] {
+	return &View[K, V]{
+		tree:  tree,
+		upper: bound[K]{key: to, present: true},
+	}
+}
+
+// TailMap returns a View over the entries
+// with keys greater than or equal to the specified key.
+func (tree *Tree[K, V]) TailMap(from K) *View[K, V] {
+	return &View[K, V]{
+		tree:  tree,
+		lower: bound[K]{key: from, inclusive: true, present: true},
+	}
+}
+
+// SubMap returns a View over the entries
+// with keys in the range [from, to).
+func (tree *Tree[K, V]) SubMap(from, to K) *View[K, V] {
+	return &View[K, V]{
+		tree:  tree,
+		lower: bound[K]{key: from, inclusive: true, present: true},
+		upper: bound[K]{key: to, present: true},
+	}
+}
+
+func (view *View[K, V]) belowLower(key K) bool {
+	if !view.lower.present {
+		return false
+	}
+
+	switch view.tree.comparator(key, view.lower.key) {
+	case comparison.FirstSmaller:
+		return true
+	case comparison.Equal:
+		return !view.lower.inclusive
+	default:
+		return false
+	}
+}
+
+func (view *View[K, V]) aboveUpper(key K) bool {
+	if !view.upper.present {
+		return false
+	}
+
+	switch view.tree.comparator(key, view.upper.key) {
+	case comparison.FirstBigger:
+		return true
+	case comparison.Equal:
+		return !view.upper.inclusive
+	default:
+		return false
+	}
+}
+
+func (view *View[K, V]) inRange(key K) bool {
+	return !view.belowLower(key) && !view.aboveUpper(key)
+}
+
+func (view *View[K, V]) restrict(lower, upper bound[K]) *View[K, V] {
+	restricted := *view
+
+	if lower.present && !view.belowLower(lower.key) {
+		restricted.lower = lower
+	}
+
+	if upper.present && !view.aboveUpper(upper.key) {
+		restricted.upper = upper
+	}
+
+	return &restricted
+}
+
+// HeadMap returns a View over the entries of this View
+// with keys strictly less than the specified key.
+func (view *View[K, V]) HeadMap(to K) *View[K, V] {
+	return view.restrict(bound[K]{}, bound[K]{key: to, present: true})
+}
+
+// TailMap returns a View over the entries of this View
+// with keys greater than or equal to the specified key.
+func (view *View[K, V]) TailMap(from K) *View[K, V] {
+	return view.restrict(bound[K]{key: from, inclusive: true, present: true}, bound[K]{})
+}
+
+// SubMap returns a View over the entries of this View
+// with keys in the range [from, to).
+func (view *View[K, V]) SubMap(from, to K) *View[K, V] {
+	return view.restrict(
+		bound[K]{key: from, inclusive: true, present: true},
+		bound[K]{key: to, present: true},
+	)
+}
+
+// FirstNode returns the node with the smallest key in the View.
+// Returns nil if the View is empty.
+func (view *View[K, V]) FirstNode() *Node[K, V] {
+	var node *Node[K, V]
+	if !view.lower.present {
+		node = view.tree.FirstNode()
+	} else if view.lower.inclusive {
+		node = view.tree.CeilingNode(view.lower.key)
+	} else {
+		node = view.tree.HigherNode(view.lower.key)
+	}
+
+	if node == nil || view.aboveUpper(node.key) {
+		return nil
+	}
+
+	return node
+}
+
+// LastNode returns the node with the greatest key in the View.
+// Returns nil if the View is empty.
+func (view *View[K, V]) LastNode() *Node[K, V] {
+	var node *Node[K, V]
+	if !view.upper.present {
+		node = view.tree.LastNode()
+	} else if view.upper.inclusive {
+		node = view.tree.FloorNode(view.upper.key)
+	} else {
+		node = view.tree.LowerNode(view.upper.key)
+	}
+
+	if node == nil || view.belowLower(node.key) {
+		return nil
+	}
+
+	return node
+}
+
+// GetNode returns the node associated with the specified key.
+// Returns nil if the key is not present or is out of range.
+func (view *View[K, V]) GetNode(key K) *Node[K, V] {
+	if !view.inRange(key) {
+		return nil
+	}
+
+	return view.tree.GetNode(key)
+}
+
+func (view *View[K, V]) offset() int {
+	if !view.lower.present {
+		return 0
+	}
+
+	return view.tree.countBelow(view.lower.key, !view.lower.inclusive)
+}
+
+// Size returns the number of entries in the View.
+func (view *View[K, V]) Size() int {
+	lowerCount := view.offset()
+
+	upperCount := view.tree.nodes
+	if view.upper.present {
+		upperCount = view.tree.countBelow(view.upper.key, view.upper.inclusive)
+	}
+
+	return max(upperCount-lowerCount, 0)
+}
+
+// Contains returns true if the View contains the specified key.
+func (view *View[K, V]) Contains(key K) bool {
+	return view.GetNode(key) != nil
+}
+
+// TryGet returns the value associated with the specified key,
+// or zero value and false if the key is not present.
+func (view *View[K, V]) TryGet(key K) (V, bool) {
+	node := view.GetNode(key)
+	if node == nil {
+		var zero V
+		return zero, false
+	}
+
+	return node.Value, true
+}
+
+// Get returns the value associated with the specified key.
+// Panics if the key is not present.
+func (view *View[K, V]) Get(key K) V {
+	return *view.GetRef(key)
+}
+
+// GetRef returns a reference to the value associated with the specified key.
+// Panics if the key is not present.
+func (view *View[K, V]) GetRef(key K) *V {
+	node := view.GetNode(key)
+	if node == nil {
+		panic(maps.MissingKeyError[K]{Key: key})
+	}
+
+	return &node.Value
+}
+
+// Set sets the value associated with the specified key
+// in the underlying Tree.
+//
+// Panic maps.KeyOutOfRangeError occurs
+// if the key is out of range of the View.
+func (view *View[K, V]) Set(key K, value V) {
+	if !view.inRange(key) {
+		panic(maps.KeyOutOfRangeError[K]{Key: key})
+	}
+
+	view.tree.Set(key, value)
+}
+
+// Remove removes the entry with the specified key
+// from the underlying Tree.
+// Does nothing if the key is not present or is out of range.
+func (view *View[K, V]) Remove(key K) {
+	node := view.GetNode(key)
+	if node != nil {
+		view.tree.removeNode(node)
+	}
+}
+
+// Clear removes all entries of the View
+// from the underlying Tree.
+func (view *View[K, V]) Clear() {
+	for it := view.MapIterator(); it.Valid(); {
+		it.Remove()
+	}
+}
+
+// Clone returns a new Tree containing
+// copies of the entries in the View.
+func (view *View[K, V]) Clone() maps.Map[K, V] {
+	cloned := NewWithComparator[K, V](view.tree.comparator)
+	for it := view.MapIterator(); it.Valid(); it.Move() {
+		cloned.Set(it.Key(), it.Value())
+	}
+
+	return cloned
+}
+
+// Iterator returns an iter.Iterator over the View.
+func (view *View[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return view.MapIterator()
+}
+
+// MapIterator returns an iterator over the View.
+func (view *View[K, V]) MapIterator() maps.Iterator[K, V] {
+	return &ViewIterator[K, V]{
+		Iterator: Iterator[K, V]{view.tree, view.FirstNode()},
+		view:     view,
+	}
+}
+
+// Stream2 streams over the entries in the View.
+func (view *View[K, V]) Stream2(yield func(K, V) bool) {
+	for it := view.MapIterator(); it.Valid(); it.Move() {
+		if !yield(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
+
+// ReverseStream2 streams over the entries in the View
+// in the reverse order of the keys.
+func (view *View[K, V]) ReverseStream2(yield func(K, V) bool) {
+	for node := view.LastNode(); node != nil && !view.belowLower(node.key); node = node.Prev() {
+		if !yield(node.key, node.Value) {
+			return
+		}
+	}
+}
+
+// Keys streams the keys of the View.
+func (view *View[K, V]) Keys(yield func(K) bool) {
+	for it := view.MapIterator(); it.Valid(); it.Move() {
+		if !yield(it.Key()) {
+			return
+		}
+	}
+}
+
+// Values streams the values of the View.
+func (view *View[K, V]) Values(yield func(V) bool) {
+	for it := view.MapIterator(); it.Valid(); it.Move() {
+		if !yield(it.Value()) {
+			return
+		}
+	}
+}
+
+func (view *View[K, V]) checkedNode(node *Node[K, V]) *Node[K, V] {
+	if node == nil || !view.inRange(node.key) {
+		return nil
+	}
+
+	return node
+}
+
+// Floor returns the entry in the View with the greatest key
+// less than or equal to the specified key.
+// Returns false if there is no such entry.
+func (view *View[K, V]) Floor(key K) (misc.Pair[K, V], bool) {
+	if view.aboveUpper(key) {
+		return nodeEntry(view.LastNode())
+	}
+
+	return nodeEntry(view.checkedNode(view.tree.FloorNode(key)))
+}
+
+// Ceiling returns the entry in the View with the smallest key
+// greater than or equal to the specified key.
+// Returns false if there is no such entry.
+func (view *View[K, V]) Ceiling(key K) (misc.Pair[K, V], bool) {
+	if view.belowLower(key) {
+		return nodeEntry(view.FirstNode())
+	}
+
+	return nodeEntry(view.checkedNode(view.tree.CeilingNode(key)))
+}
+
+// Lower returns the entry in the View with the greatest key
+// strictly less than the specified key.
+// Returns false if there is no such entry.
+func (view *View[K, V]) Lower(key K) (misc.Pair[K, V], bool) {
+	if view.aboveUpper(key) {
+		return nodeEntry(view.LastNode())
+	}
+
+	return nodeEntry(view.checkedNode(view.tree.LowerNode(key)))
+}
+
+// Higher returns the entry in the View with the smallest key
+// strictly greater than the specified key.
+// Returns false if there is no such entry.
+func (view *View[K, V]) Higher(key K) (misc.Pair[K, V], bool) {
+	if view.belowLower(key) {
+		return nodeEntry(view.FirstNode())
+	}
+
+	return nodeEntry(view.checkedNode(view.tree.HigherNode(key)))
+}
+
+// First returns the entry in the View with the smallest key.
+// Returns false if the View is empty.
+func (view *View[K, V]) First() (misc.Pair[K, V], bool) {
+	return nodeEntry(view.FirstNode())
+}
+
+// Last returns the entry in the View with the greatest key.
+// Returns false if the View is empty.
+func (view *View[K, V]) Last() (misc.Pair[K, V], bool) {
+	return nodeEntry(view.LastNode())
+}
+
+// PollFirst removes and returns the entry in the View with the smallest key.
+// Returns false if the View is empty.
+func (view *View[K, V]) PollFirst() (misc.Pair[K, V], bool) {
+	node := view.FirstNode()
+	if node == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	view.tree.removeNode(node)
+
+	return misc.MakePair(node.key, node.Value), true
+}
+
+// PollLast removes and returns the entry in the View with the greatest key.
+// Returns false if the View is empty.
+func (view *View[K, V]) PollLast() (misc.Pair[K, V], bool) {
+	node := view.LastNode()
+	if node == nil {
+		return misc.Pair[K, V]{}, false
+	}
+
+	view.tree.removeNode(node)
+
+	return misc.MakePair(node.key, node.Value), true
+}
+
+// Tree returns the Tree that backs the View.
+func (view *View[K, V]) Tree() *Tree[K, V] {
+	return view.tree
+}
+
+// ViewIterator is an iterator over a View.
+type ViewIterator[K, V any] struct {
+	Iterator[K, V]
+
+	view *View[K, V]
+}
+
+// Valid returns true if it points to a valid entry
+// that is in the range of the View.
+func (it *ViewIterator[K, V]) Valid() bool {
+	return it.curr != nil && it.view.inRange(it.curr.key)
+}
+
+// Move moves the iterator to the next entry.
+// It does nothing if the iterator is already out of the View.
+func (it *ViewIterator[K, V]) Move() {
+	if it.Valid() {
+		it.Iterator.Move()
+	}
+}
+
+// MoveBack moves the iterator to the previous entry.
+// It does nothing if the iterator is already out of the View.
+func (it *ViewIterator[K, V]) MoveBack() {
+	if it.Valid() {
+		it.Iterator.MoveBack()
+	}
+}
+
+// Seek moves the iterator to the entry
+// at the specified position in the View.
+// Seeking out of bounds makes the iterator invalid.
+func (it *ViewIterator[K, V]) Seek(index int) {
+	if index < 0 || index >= it.view.Size() {
+		it.curr = nil
+		return
+	}
+
+	it.curr = it.tree.SelectNode(it.view.offset() + index)
+}
+
+// Index returns the position of the current entry
+// in the View, or the size of the View
+// if the iterator is not valid.
+func (it *ViewIterator[K, V]) Index() int {
+	if !it.Valid() {
+		return it.view.Size()
+	}
+
+	return it.curr.Index() - it.view.offset()
+}
+
+// Distance returns the number of moves needed
+// to get from the current entry to the entry of the other iterator.
+func (it *ViewIterator[K, V]) Distance(other iter.RandomAccessIterator[misc.Pair[K, V]]) int {
+	return other.Index() - it.Index()
+}
+
+// SeekFirst moves the iterator to the entry
+// with the smallest key in the View.
+func (it *ViewIterator[K, V]) SeekFirst() {
+	it.curr = it.view.FirstNode()
+}
+
+// SeekLast moves the iterator to the entry
+// with the greatest key in the View.
+func (it *ViewIterator[K, V]) SeekLast() {
+	it.curr = it.view.LastNode()
+}
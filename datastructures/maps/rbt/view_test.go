@@ -0,0 +1,133 @@
+package rbt
+
+import (
+	"errors"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"slices"
+	"testing"
+)
+
+func TestViewWriteThrough(t *testing.T) {
+	tree := newTensTree()
+	view := tree.SubMap(20, 50)
+
+	view.Set(25, 100)
+	if tree.Get(25) != 100 || view.Size() != 4 {
+		t.Errorf("Set() through view: tree has %d, view size is %d", tree.Get(25), view.Size())
+	}
+
+	tree.Set(45, 200)
+	tree.Set(55, 300)
+	if !view.Contains(45) || view.Contains(55) || view.Size() != 5 {
+		t.Errorf("view doesn't follow the tree, size is %d", view.Size())
+	}
+
+	view.Remove(30)
+	view.Remove(60)
+	if tree.Contains(30) || !tree.Contains(60) {
+		t.Error("Remove() through view removed a wrong key")
+	}
+
+	*view.GetRef(40) = -4
+	if tree.Get(40) != -4 {
+		t.Errorf("GetRef() through view, tree has %d", tree.Get(40))
+	}
+
+	for _, key := range []int{10, 50, 60} {
+		func() {
+			defer func() {
+				var rangeErr maps.KeyOutOfRangeError[int]
+				if err, _ := recover().(error); !errors.As(err, &rangeErr) || rangeErr.Key != key {
+					t.Errorf("Set(%d) panicked with %v", key, err)
+				}
+			}()
+
+			view.Set(key, 0)
+		}()
+	}
+
+	if _, ok := view.TryGet(10); ok {
+		t.Error("TryGet() returned a key out of range")
+	}
+}
+
+func TestNestedViews(t *testing.T) {
+	tree := newTensTree()
+	view := tree.SubMap(20, 70)
+
+	tests := []struct {
+		view     *View[int, int]
+		expected []int
+	}{
+		{view.SubMap(0, 90), []int{20, 30, 40, 50, 60}},
+		{view.SubMap(35, 55), []int{40, 50}},
+		{view.HeadMap(40), []int{20, 30}},
+		{view.TailMap(55), []int{60}},
+		{view.HeadMap(90).TailMap(0), []int{20, 30, 40, 50, 60}},
+		{view.TailMap(60).HeadMap(60), nil},
+		{tree.HeadMap(30), []int{0, 10, 20}},
+		{tree.TailMap(75), []int{80, 90}},
+	}
+
+	for i, test := range tests {
+		if keys := slices.Collect(test.view.Keys); !slices.Equal(keys, test.expected) {
+			t.Errorf("view %d has keys %v, expected %v", i, keys, test.expected)
+		}
+
+		if test.view.Size() != len(test.expected) {
+			t.Errorf("view %d has size %d", i, test.view.Size())
+		}
+	}
+}
+
+func TestViewNavigation(t *testing.T) {
+	tree := newTensTree()
+	view := tree.SubMap(20, 70)
+
+	tests := []struct {
+		name     string
+		method   func(int) (int, bool)
+		key      int
+		expected int
+		ok       bool
+	}{
+		{"Floor", keyOf(view.Floor), 95, 60, true},
+		{"Floor", keyOf(view.Floor), 15, 0, false},
+		{"Ceiling", keyOf(view.Ceiling), 5, 20, true},
+		{"Ceiling", keyOf(view.Ceiling), 65, 0, false},
+		{"Lower", keyOf(view.Lower), 20, 0, false},
+		{"Lower", keyOf(view.Lower), 70, 60, true},
+		{"Higher", keyOf(view.Higher), 10, 20, true},
+		{"Higher", keyOf(view.Higher), 60, 0, false},
+	}
+
+	for _, test := range tests {
+		if key, ok := test.method(test.key); key != test.expected || ok != test.ok {
+			t.Errorf("%s(%d) = %d, %v", test.name, test.key, key, ok)
+		}
+	}
+
+	if entry, ok := view.PollFirst(); !ok || entry.First != 20 || tree.Contains(20) {
+		t.Errorf("PollFirst() = %v, %v", entry, ok)
+	}
+	if entry, ok := view.PollLast(); !ok || entry.First != 60 || tree.Contains(60) {
+		t.Errorf("PollLast() = %v, %v", entry, ok)
+	}
+
+	view.Clear()
+	if view.Size() != 0 || tree.Size() != 5 {
+		t.Errorf("Clear() left view size %d and tree size %d", view.Size(), tree.Size())
+	}
+
+	if _, ok := view.First(); ok {
+		t.Error("First() of an empty view returned an entry")
+	}
+}
+
+func keyOf(method func(int) (misc.Pair[int, int], bool)) func(int) (int, bool) {
+	return func(key int) (int, bool) {
+		entry, ok := method(key)
+		return entry.First, ok
+	}
+}
@@ -0,0 +1,86 @@
+package shardmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/misc"
+)
+
+// Iterator is an iterator over a Map.
+//
+// Entries of each shard are copied under its read lock
+// when the iterator reaches the shard, so every key is
+// returned together with the value it had at that moment.
+// Writes lock the shard and are applied to the Map by key.
+type Iterator[K comparable, V any] struct {
+	m *Map[K, V]
+
+	shard   int
+	entries []misc.Pair[K, V]
+	index   int
+}
+
+func (it *Iterator[K, V]) currentShard() *shard[K, V] {
+	return &it.m.shards[it.shard]
+}
+
+func (it *Iterator[K, V]) nextShard() {
+	for it.index >= len(it.entries) && it.shard < len(it.m.shards)-1 {
+		it.shard++
+		it.entries = it.currentShard().entries()
+		it.index = 0
+	}
+}
+
+// Valid returns if the iterator is
+// currently pointing to a valid entry.
+func (it *Iterator[K, V]) Valid() bool {
+	return it.index < len(it.entries)
+}
+
+// Move moves to the next entry.
+func (it *Iterator[K, V]) Move() {
+	if !it.Valid() {
+		return
+	}
+
+	it.index++
+	it.nextShard()
+}
+
+// Get returns the current entry as a key-value misc.Pair
+func (it *Iterator[K, V]) Get() misc.Pair[K, V] {
+	return it.entries[it.index]
+}
+
+// Key returns the key of the current entry.
+func (it *Iterator[K, V]) Key() K {
+	return it.entries[it.index].First
+}
+
+// Value returns the value of the current entry.
+func (it *Iterator[K, V]) Value() V {
+	return it.entries[it.index].Second
+}
+
+// ValueRef returns a reference to the value of the current entry.
+// Panic occurs if the entry was removed from the Map in the meantime.
+//
+// Usage of this method is discouraged, as it breaks the thread-safety.
+// Lock will not be held while the reference is used, so it is possible
+// that the value of the entry changes while the reference is used.
+func (it *Iterator[K, V]) ValueRef() *V {
+	return it.m.GetRef(it.Key())
+}
+
+// SetValue sets the value of the current entry.
+// If the entry was removed from the Map in the meantime, it is added again.
+func (it *Iterator[K, V]) SetValue(value V) {
+	it.m.Set(it.Key(), value)
+	it.entries[it.index].Second = value
+}
+
+// Remove removes the current entry.
+// The iterator will point to the next entry afterward.
+func (it *Iterator[K, V]) Remove() {
+	it.m.Remove(it.Key())
+	it.Move()
+}
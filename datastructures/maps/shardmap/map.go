@@ -0,0 +1,309 @@
+package shardmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/iter"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/misc"
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
+	"runtime"
+	"sync"
+)
+
+type shard[K comparable, V any] struct {
+	m hashmap.Map[K, V]
+
+	mutex sync.RWMutex
+}
+
+// Map is a concurrent hash map that splits
+// its entries into multiple shards.
+//
+// Every shard is guarded by its own read-write mutex,
+// so goroutines that access keys from different shards
+// don't block each other.
+type Map[K comparable, V any] struct {
+	shards []shard[K, V]
+
+	hasher hashing.Hasher[K]
+}
+
+// NewWithHasher creates an empty Map with the given
+// number of shards and the given hashing.Hasher for keys.
+//
+// Panic occurs if the number of shards is not positive.
+func NewWithHasher[K comparable, V any](shards int, hasher hashing.Hasher[K]) *Map[K, V] {
+	if shards <= 0 {
+		panic("number of shards must be positive")
+	}
+
+	m := &Map[K, V]{
+		shards: make([]shard[K, V], shards),
+		hasher: hasher,
+	}
+
+	for i := range m.shards {
+		m.shards[i].m = hashmap.New[K, V]()
+	}
+
+	return m
+}
+
+// NewWithShards creates an empty Map with the given number of shards
+// that hashes keys with hashing.DefaultHasher.
+//
+// Panic occurs if the number of shards is not positive.
+func NewWithShards[K comparable, V any](shards int) *Map[K, V] {
+	return NewWithHasher[K, V](shards, hashing.DefaultHasher[K]())
+}
+
+// New creates an empty Map with a number of shards
+// proportional to the number of CPUs.
+func New[K comparable, V any]() *Map[K, V] {
+	return NewWithShards[K, V](4 * runtime.GOMAXPROCS(0))
+}
+
+// NewFromIterable creates a Map from the specified iter.Iterable.
+func NewFromIterable[K comparable, V any](iterable iter.Iterable[misc.Pair[K, V]]) *Map[K, V] {
+	m := New[K, V]()
+
+	for it := iterable.Iterator(); it.Valid(); it.Move() {
+		entry := it.Get()
+		m.Set(entry.First, entry.Second)
+	}
+
+	return m
+}
+
+func (s *shard[K, V]) entries() []misc.Pair[K, V] {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	entries := make([]misc.Pair[K, V], 0, len(s.m))
+	for k, v := range s.m {
+		entries = append(entries, misc.MakePair(k, v))
+	}
+
+	return entries
+}
+
+func (m *Map[K, V]) shardFor(key K) *shard[K, V] {
+	return &m.shards[m.hasher(key)%uint64(len(m.shards))]
+}
+
+// Shards returns the number of shards.
+func (m *Map[K, V]) Shards() int {
+	return len(m.shards)
+}
+
+// Size returns the number of entries.
+//
+// Shards are counted one by one, so the result
+// may be inaccurate if the Map is modified concurrently.
+func (m *Map[K, V]) Size() int {
+	size := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+
+		s.mutex.RLock()
+		size += s.m.Size()
+		s.mutex.RUnlock()
+	}
+
+	return size
+}
+
+// Contains returns true if the given key is present.
+func (m *Map[K, V]) Contains(key K) bool {
+	s := m.shardFor(key)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.m.Contains(key)
+}
+
+// TryGet returns the value associated with the
+// given key if it is present.
+// If the key is not present, it returns the zero value
+// for the value type and false.
+func (m *Map[K, V]) TryGet(key K) (V, bool) {
+	s := m.shardFor(key)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.m.TryGet(key)
+}
+
+// Get returns the value associated with the given key.
+//
+// Panic occurs if the key is not present.
+func (m *Map[K, V]) Get(key K) V {
+	s := m.shardFor(key)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.m.Get(key)
+}
+
+// GetRef returns a reference to the value associated with the given key.
+//
+// Usage of this method is discouraged, as it breaks the thread-safety.
+// Lock will not be held while the reference is used, so it is possible
+// that the value of the entry changes while the reference is used.
+func (m *Map[K, V]) GetRef(key K) *V {
+	s := m.shardFor(key)
+
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.m.GetRef(key)
+}
+
+// Set sets the value associated with the given key.
+func (m *Map[K, V]) Set(key K, value V) {
+	s := m.shardFor(key)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.m.Set(key, value)
+}
+
+// Update calculates and sets the new value
+// of the entry at the given key using
+// the given update function.
+//
+// Panic occurs if the key is not present.
+func (m *Map[K, V]) Update(key K, updateFunction func(oldValue V) V) {
+	s := m.shardFor(key)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	oldValue := s.m.Get(key)
+	newValue := updateFunction(oldValue)
+
+	s.m.Set(key, newValue)
+}
+
+// UpdateRef updates the value at the given key
+// in-place using the given update function.
+//
+// Panic occurs if the key is not present.
+func (m *Map[K, V]) UpdateRef(key K, updateFunction func(oldValue *V)) {
+	s := m.shardFor(key)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	oldValue := s.m.GetRef(key)
+	updateFunction(oldValue)
+}
+
+// Transaction executes the given function on the entry
+// with the given key while holding the write lock of its shard.
+//
+// The function receives the current value and whether
+// the entry is present. It returns the new value and whether
+// the entry should be kept. If it is not kept, it is removed.
+func (m *Map[K, V]) Transaction(key K, transactionFunction func(value V, present bool) (V, bool)) {
+	s := m.shardFor(key)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	oldValue, present := s.m.TryGet(key)
+	newValue, keep := transactionFunction(oldValue, present)
+
+	if keep {
+		s.m.Set(key, newValue)
+	} else if present {
+		s.m.Remove(key)
+	}
+}
+
+// Remove removes the entry with the given key.
+func (m *Map[K, V]) Remove(key K) {
+	s := m.shardFor(key)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.m.Remove(key)
+}
+
+// Clear removes all entries.
+func (m *Map[K, V]) Clear() {
+	for i := range m.shards {
+		s := &m.shards[i]
+
+		s.mutex.Lock()
+		s.m.Clear()
+		s.mutex.Unlock()
+	}
+}
+
+// Clone returns a new Map with the same
+// shards, hash function and entries.
+func (m *Map[K, V]) Clone() maps.Map[K, V] {
+	cloned := &Map[K, V]{
+		shards: make([]shard[K, V], len(m.shards)),
+		hasher: m.hasher,
+	}
+
+	for i := range m.shards {
+		s := &m.shards[i]
+
+		s.mutex.RLock()
+		cloned.shards[i].m = s.m.Clone().(hashmap.Map[K, V])
+		s.mutex.RUnlock()
+	}
+
+	return cloned
+}
+
+// Iterator returns a read-only iter.Iterator over the entries.
+func (m *Map[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
+	return m.MapIterator()
+}
+
+// MapIterator returns an Iterator over the entries.
+// It can be used to modify the entries while iterating.
+//
+// Shards are iterated one after another, and the entries
+// of each shard are copied when the iterator reaches it.
+func (m *Map[K, V]) MapIterator() maps.Iterator[K, V] {
+	it := &Iterator[K, V]{m: m, shard: -1}
+	it.nextShard()
+
+	return it
+}
+
+// Stream2 streams all entries.
+//
+// Entries of each shard are copied under its read lock
+// before they are streamed, so no lock is held while streaming
+// and the Map can be modified in the loop. Changes to the shards
+// that haven't been streamed yet are reflected in the stream.
+func (m *Map[K, V]) Stream2(yield func(K, V) bool) {
+	for i := range m.shards {
+		for _, entry := range m.shards[i].entries() {
+			if !yield(entry.First, entry.Second) {
+				return
+			}
+		}
+	}
+}
+
+// Keys streams the keys.
+func (m *Map[K, V]) Keys(yield func(K) bool) {
+	m.Stream2(func(key K, _ V) bool { return yield(key) })
+}
+
+// Values streams the values.
+func (m *Map[K, V]) Values(yield func(V) bool) {
+	m.Stream2(func(_ K, value V) bool { return yield(value) })
+}
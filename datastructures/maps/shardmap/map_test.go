@@ -0,0 +1,171 @@
+package shardmap
+
+import (
+	"testing"
+	"time"
+)
+
+func runWithTimeout(t *testing.T, f func()) {
+	t.Helper()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("deadlock")
+	}
+}
+
+func TestStream2(t *testing.T) {
+	m := NewWithShards[int, int](4)
+	for i := range 100 {
+		m.Set(i, i*i)
+	}
+
+	seen := make(map[int]bool)
+	for key, value := range m.Stream2 {
+		if value != key*key || seen[key] {
+			t.Fatalf("streamed (%d, %d)", key, value)
+		}
+
+		seen[key] = true
+	}
+
+	if len(seen) != 100 {
+		t.Errorf("streamed %d entries", len(seen))
+	}
+
+	count := 0
+	for range m.Keys {
+		count++
+		if count == 10 {
+			break
+		}
+	}
+
+	if count != 10 {
+		t.Errorf("streamed %d keys after breaking", count)
+	}
+}
+
+func TestStream2WithWrites(t *testing.T) {
+	m := NewWithShards[int, int](4)
+	for i := range 100 {
+		m.Set(i, i)
+	}
+
+	runWithTimeout(t, func() {
+		for key, value := range m.Stream2 {
+			m.Set(key, value+1)
+			m.Remove(key + 1000)
+		}
+	})
+
+	for key, value := range m.Stream2 {
+		if value != key+1 {
+			t.Errorf("entry (%d, %d) was not updated once", key, value)
+		}
+	}
+}
+
+func TestStream2Panic(t *testing.T) {
+	m := NewWithShards[int, int](1)
+	m.Set(1, 1)
+
+	func() {
+		defer func() { recover() }()
+
+		for range m.Stream2 {
+			panic("failure in the loop")
+		}
+	}()
+
+	runWithTimeout(t, func() { m.Set(2, 2) })
+
+	if m.Size() != 2 {
+		t.Errorf("Size() = %d", m.Size())
+	}
+}
+
+func TestIteratorWithConcurrentWrites(t *testing.T) {
+	m := NewWithShards[int, int](4)
+	for i := range 100 {
+		m.Set(i, i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := range 100 {
+			m.Remove(i)
+			m.Set(i, i)
+		}
+	}()
+
+	seen := 0
+	for it := m.MapIterator(); it.Valid(); it.Move() {
+		if key, value := it.Key(), it.Value(); key != value {
+			t.Errorf("iterator returned (%d, %d)", key, value)
+		}
+		seen++
+	}
+	<-done
+
+	if seen > 100 {
+		t.Errorf("iterator visited %d entries", seen)
+	}
+}
+
+func TestIteratorWrites(t *testing.T) {
+	m := NewWithShards[int, int](4)
+	for i := range 100 {
+		m.Set(i, i)
+	}
+
+	visited := 0
+	for it := m.MapIterator(); it.Valid(); visited++ {
+		if key := it.Key(); key%2 == 0 {
+			it.Remove()
+		} else {
+			it.SetValue(-key)
+			if it.Value() != -key {
+				t.Errorf("Value() after SetValue() = %d", it.Value())
+			}
+			it.Move()
+		}
+	}
+
+	if visited != 100 || m.Size() != 50 {
+		t.Errorf("visited %d entries, size is %d", visited, m.Size())
+	}
+
+	for key, value := range m.Stream2 {
+		if key%2 == 0 || value != -key {
+			t.Errorf("entry (%d, %d) left after iteration", key, value)
+		}
+	}
+}
+
+func TestIteratorAfterRemoval(t *testing.T) {
+	m := NewWithShards[int, int](1)
+	m.Set(1, 10)
+	m.Set(2, 20)
+
+	it := m.MapIterator()
+	key := it.Key()
+	m.Remove(key)
+
+	if value := it.Value(); value != key*10 {
+		t.Errorf("Value() of a removed entry = %d", value)
+	}
+
+	it.Move()
+	if !it.Valid() || it.Key() == key {
+		t.Error("iterator did not move to the remaining entry")
+	}
+}
@@ -0,0 +1,138 @@
+package syncmap
+
+func (w *Wrapper[K, V]) compute(key K, computeFunction func(oldValue V, present bool) (V, bool)) (V, bool) {
+	oldValue, present := w.m.TryGet(key)
+	newValue, keep := computeFunction(oldValue, present)
+
+	if keep {
+		w.modifiable().Set(key, newValue)
+		return newValue, true
+	}
+
+	if present {
+		w.modifiable().Remove(key)
+	}
+
+	var zero V
+	return zero, false
+}
+
+// Compute calculates the new value of the entry
+// at the given key using the given compute function.
+//
+// The function receives the current value and whether
+// the entry is present. It returns the new value and whether
+// the entry should be kept. If it is not kept, it is removed.
+//
+// Returns the new value and whether the entry is present.
+func (w *Wrapper[K, V]) Compute(key K, computeFunction func(oldValue V, present bool) (V, bool)) (V, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.compute(key, computeFunction)
+}
+
+// ComputeIfAbsent returns the value of the entry at the given key.
+// If the entry is not present, its value is calculated
+// using the given compute function and set.
+func (w *Wrapper[K, V]) ComputeIfAbsent(key K, computeFunction func(key K) V) V {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	value, _ := w.compute(key, func(oldValue V, present bool) (V, bool) {
+		if present {
+			return oldValue, true
+		}
+
+		return computeFunction(key), true
+	})
+
+	return value
+}
+
+// ComputeIfPresent calculates the new value of the entry
+// at the given key using the given compute function,
+// if the entry is present.
+//
+// The function returns the new value and whether
+// the entry should be kept. If it is not kept, it is removed.
+//
+// Returns the new value and whether the entry is present.
+func (w *Wrapper[K, V]) ComputeIfPresent(key K, computeFunction func(key K, oldValue V) (V, bool)) (V, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.compute(key, func(oldValue V, present bool) (V, bool) {
+		if !present {
+			return oldValue, false
+		}
+
+		return computeFunction(key, oldValue)
+	})
+}
+
+// Merge sets the given value at the given key if the entry is not present.
+// Otherwise, the new value is calculated from the current
+// and the given value using the given merge function.
+//
+// The function returns the new value and whether
+// the entry should be kept. If it is not kept, it is removed.
+//
+// Returns the new value and whether the entry is present.
+func (w *Wrapper[K, V]) Merge(key K, value V, mergeFunction func(oldValue, value V) (V, bool)) (V, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.compute(key, func(oldValue V, present bool) (V, bool) {
+		if !present {
+			return value, true
+		}
+
+		return mergeFunction(oldValue, value)
+	})
+}
+
+// GetOrSet returns the value of the entry at the given key
+// and true if it is present.
+// Otherwise, it sets the given value and returns it and false.
+func (w *Wrapper[K, V]) GetOrSet(key K, value V) (V, bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if oldValue, ok := w.m.TryGet(key); ok {
+		return oldValue, true
+	}
+
+	w.modifiable().Set(key, value)
+	return value, false
+}
+
+// CompareAndSwap sets the new value of the entry at the given key
+// if the entry is present and its value is equal to the old value.
+// Returns true if the value was swapped.
+func CompareAndSwap[K any, V comparable](w *Wrapper[K, V], key K, oldValue, newValue V) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if value, ok := w.m.TryGet(key); !ok || value != oldValue {
+		return false
+	}
+
+	w.modifiable().Set(key, newValue)
+	return true
+}
+
+// CompareAndDelete removes the entry at the given key
+// if the entry is present and its value is equal to the old value.
+// Returns true if the entry was removed.
+func CompareAndDelete[K any, V comparable](w *Wrapper[K, V], key K, oldValue V) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if value, ok := w.m.TryGet(key); !ok || value != oldValue {
+		return false
+	}
+
+	w.modifiable().Remove(key)
+	return true
+}
@@ -0,0 +1,153 @@
+package syncmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"sync"
+	"testing"
+)
+
+func TestCompute(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+	w.Set("a", 1)
+
+	increment := func(oldValue int, present bool) (int, bool) {
+		if !present {
+			return 1, true
+		}
+
+		return oldValue + 1, oldValue < 2
+	}
+
+	if value, ok := w.Compute("a", increment); !ok || value != 2 || w.Get("a") != 2 {
+		t.Errorf("Compute() on present key = %d, %v", value, ok)
+	}
+	if value, ok := w.Compute("b", increment); !ok || value != 1 || w.Get("b") != 1 {
+		t.Errorf("Compute() on missing key = %d, %v", value, ok)
+	}
+
+	w.Snapshot()
+	if value, ok := w.Compute("a", increment); ok || value != 0 || w.Contains("a") {
+		t.Errorf("Compute() that removes = %d, %v", value, ok)
+	}
+	if w.Snapshot().Contains("a") {
+		t.Error("snapshot was not invalidated by removal in Compute()")
+	}
+
+	removeMissing := func(int, bool) (int, bool) { return 0, false }
+	if _, ok := w.Compute("c", removeMissing); ok || w.Size() != 1 {
+		t.Errorf("Compute() that removes a missing key changed the size to %d", w.Size())
+	}
+}
+
+func TestComputeIfAbsentAndPresent(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+
+	calls := 0
+	length := func(key string) int {
+		calls++
+		return len(key)
+	}
+
+	if value := w.ComputeIfAbsent("abc", length); value != 3 {
+		t.Errorf("ComputeIfAbsent() = %d", value)
+	}
+	w.Set("abc", 10)
+	if value := w.ComputeIfAbsent("abc", length); value != 10 || calls != 1 {
+		t.Errorf("ComputeIfAbsent() on present key = %d, function called %d times", value, calls)
+	}
+
+	double := func(key string, oldValue int) (int, bool) { return oldValue * 2, oldValue < 20 }
+	if value, ok := w.ComputeIfPresent("abc", double); !ok || value != 20 {
+		t.Errorf("ComputeIfPresent() = %d, %v", value, ok)
+	}
+	if value, ok := w.ComputeIfPresent("missing", double); ok || value != 0 || w.Contains("missing") {
+		t.Errorf("ComputeIfPresent() on missing key = %d, %v", value, ok)
+	}
+	if _, ok := w.ComputeIfPresent("abc", double); ok || w.Contains("abc") {
+		t.Error("ComputeIfPresent() didn't remove the entry")
+	}
+}
+
+func TestMergeAndGetOrSet(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+
+	sum := func(oldValue, value int) (int, bool) { return oldValue + value, oldValue+value != 0 }
+	if value, ok := w.Merge("a", 5, sum); !ok || value != 5 {
+		t.Errorf("Merge() on missing key = %d, %v", value, ok)
+	}
+	if value, ok := w.Merge("a", 3, sum); !ok || value != 8 {
+		t.Errorf("Merge() on present key = %d, %v", value, ok)
+	}
+	if _, ok := w.Merge("a", -8, sum); ok || w.Contains("a") {
+		t.Error("Merge() didn't remove the entry")
+	}
+
+	if value, present := w.GetOrSet("b", 1); present || value != 1 {
+		t.Errorf("GetOrSet() on missing key = %d, %v", value, present)
+	}
+	if value, present := w.GetOrSet("b", 2); !present || value != 1 {
+		t.Errorf("GetOrSet() on present key = %d, %v", value, present)
+	}
+}
+
+func TestCompareAndSwap(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+	w.Set("a", 1)
+
+	if CompareAndSwap(w, "a", 2, 3) || w.Get("a") != 1 {
+		t.Error("CompareAndSwap() swapped a different value")
+	}
+	if CompareAndSwap(w, "b", 0, 3) || w.Contains("b") {
+		t.Error("CompareAndSwap() swapped a missing key")
+	}
+	if !CompareAndSwap(w, "a", 1, 3) || w.Get("a") != 3 {
+		t.Error("CompareAndSwap() didn't swap the value")
+	}
+
+	if CompareAndDelete(w, "a", 1) || !w.Contains("a") {
+		t.Error("CompareAndDelete() removed a different value")
+	}
+	if CompareAndDelete(w, "b", 0) {
+		t.Error("CompareAndDelete() removed a missing key")
+	}
+	if !CompareAndDelete(w, "a", 3) || w.Contains("a") {
+		t.Error("CompareAndDelete() didn't remove the entry")
+	}
+}
+
+func TestConcurrentCompute(t *testing.T) {
+	w := From[int, int](hashmap.New[int, int]())
+
+	const goroutines, increments = 8, 1000
+
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range increments {
+				w.Merge(i%4, 1, func(oldValue, value int) (int, bool) { return oldValue + value, true })
+
+				for {
+					value, _ := w.GetOrSet(-1, 0)
+					if CompareAndSwap(w, -1, value, value+1) {
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for key := range 4 {
+		if value := w.Get(key); value != goroutines*increments/4 {
+			t.Errorf("key %d has value %d", key, value)
+		}
+	}
+
+	if value := w.Get(-1); value != goroutines*increments {
+		t.Errorf("CompareAndSwap() counter is %d", value)
+	}
+}
@@ -3,15 +3,23 @@ package syncmap
 import (
 	"github.com/djordje200179/extendedlibrary/datastructures/maps"
 	"github.com/djordje200179/extendedlibrary/misc"
-	"sync"
 )
 
 // Iterator is a wrapper around a maps.Iterator
 // that provides thread-safe access.
+//
+// If the map is copied because of a snapshot while iterating,
+// the iterator continues over the entries as they were before the copy,
+// and SetValue, ValueRef and Remove are applied to the Wrapper by key.
 type Iterator[K, V any] struct {
 	mapIt maps.Iterator[K, V]
 
-	mutex *sync.RWMutex
+	wrapper *Wrapper[K, V]
+	version uint64
+}
+
+func (it Iterator[K, V]) detached() bool {
+	return it.version != it.wrapper.version
 }
 
 // Valid returns if the iterator is
@@ -27,24 +35,34 @@ func (it Iterator[K, V]) Move() {
 
 // Get returns the current entry as a key-value misc.Pair
 func (it Iterator[K, V]) Get() misc.Pair[K, V] {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
+	it.wrapper.mutex.RLock()
+	defer it.wrapper.mutex.RUnlock()
 
-	return misc.MakePair(it.mapIt.Key(), it.mapIt.Value())
+	return misc.MakePair(it.mapIt.Key(), it.value())
 }
 
 // Key returns the key of the current entry.
 func (it Iterator[K, V]) Key() K {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
+	it.wrapper.mutex.RLock()
+	defer it.wrapper.mutex.RUnlock()
 
 	return it.mapIt.Key()
 }
 
 // Value returns the value of the current entry.
 func (it Iterator[K, V]) Value() V {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
+	it.wrapper.mutex.RLock()
+	defer it.wrapper.mutex.RUnlock()
+
+	return it.value()
+}
+
+func (it Iterator[K, V]) value() V {
+	if it.detached() {
+		if value, ok := it.wrapper.m.TryGet(it.mapIt.Key()); ok {
+			return value
+		}
+	}
 
 	return it.mapIt.Value()
 }
@@ -54,25 +72,45 @@ func (it Iterator[K, V]) Value() V {
 // Usage of this method is discouraged, as it breaks the thread-safety.
 // Lock will not be held while the reference is used, so it is possible
 // that the value of the entry changes while the reference is used.
+// The reference must not be used after the next write through the Wrapper.
 func (it Iterator[K, V]) ValueRef() *V {
-	it.mutex.RLock()
-	defer it.mutex.RUnlock()
-
-	return it.mapIt.ValueRef()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
+
+	var ref *V
+	if m := it.wrapper.modifiable(); it.detached() {
+		ref = m.GetRef(it.mapIt.Key())
+	} else {
+		ref = it.mapIt.ValueRef()
+	}
+
+	it.wrapper.referenceEscaped.Store(true)
+	return ref
 }
 
 // SetValue sets the value of the current entry.
 func (it Iterator[K, V]) SetValue(value V) {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
-
-	it.mapIt.SetValue(value)
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
+
+	m := it.wrapper.modifiable()
+	if it.detached() {
+		m.Set(it.mapIt.Key(), value)
+	} else {
+		it.mapIt.SetValue(value)
+	}
 }
 
 // Remove removes the current entry.
 func (it Iterator[K, V]) Remove() {
-	it.mutex.Lock()
-	defer it.mutex.Unlock()
-
-	it.mapIt.Remove()
+	it.wrapper.mutex.Lock()
+	defer it.wrapper.mutex.Unlock()
+
+	m := it.wrapper.modifiable()
+	if it.detached() {
+		m.Remove(it.mapIt.Key())
+		it.mapIt.Move()
+	} else {
+		it.mapIt.Remove()
+	}
 }
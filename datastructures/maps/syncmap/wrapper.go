@@ -3,8 +3,10 @@ package syncmap
 import (
 	"github.com/djordje200179/extendedlibrary/datastructures/iter"
 	"github.com/djordje200179/extendedlibrary/datastructures/maps"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/readmap"
 	"github.com/djordje200179/extendedlibrary/misc"
 	"sync"
+	"sync/atomic"
 )
 
 // Wrapper is a wrapper around a maps.Map
@@ -13,15 +15,66 @@ import (
 // Locking is done through read-write mutex.
 // This means that multiple goroutines can read
 // at the same time, but only one goroutine can write at that time.
+//
+// Snapshots share the underlying maps.Map with the Wrapper,
+// so taking one is O(1) and reading it never blocks writers.
+// The first write after a snapshot copies the map once,
+// and later writes modify the copy in place.
+//
+// A reference returned by GetRef or ValueRef
+// can be used until the next write through the Wrapper.
+// Until then, snapshots are copied instead of shared,
+// so writes through the reference are not visible in them.
 type Wrapper[K, V any] struct {
 	m maps.Map[K, V]
 
-	mutex sync.RWMutex
+	mutex   sync.RWMutex
+	version uint64
+
+	shared            atomic.Bool
+	snapshotStreaming atomic.Bool
+	referenceEscaped  atomic.Bool
 }
 
 // From creates a new Wrapper around the given maps.Map.
 func From[K, V any](m maps.Map[K, V]) *Wrapper[K, V] {
-	return &Wrapper[K, V]{m, sync.RWMutex{}}
+	return &Wrapper[K, V]{m: m}
+}
+
+// modifiable returns the map that can be modified in place.
+// If the map is shared with snapshots, it is copied first.
+// It must be called while holding the write lock.
+func (w *Wrapper[K, V]) modifiable() maps.Map[K, V] {
+	if w.shared.Load() {
+		w.m = w.m.Clone()
+		w.version++
+		w.shared.Store(false)
+	}
+
+	w.referenceEscaped.Store(false)
+	return w.m
+}
+
+// Snapshot returns a read-only view of the entries
+// at the moment of the call. Later changes to the
+// Wrapper are not reflected in the snapshot.
+func (w *Wrapper[K, V]) Snapshot() readmap.Wrapper[K, V] {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	if w.referenceEscaped.Load() {
+		return readmap.From(w.m.Clone())
+	}
+
+	w.shared.Store(true)
+	return readmap.From(w.m)
+}
+
+// SetSnapshotStreaming sets whether Stream2, Keys and Values
+// iterate over a Snapshot instead of holding the read lock
+// for the whole iteration, so writers are never blocked by them.
+func (w *Wrapper[K, V]) SetSnapshotStreaming(enabled bool) {
+	w.snapshotStreaming.Store(enabled)
 }
 
 // Size returns the number of entries.
@@ -64,11 +117,14 @@ func (w *Wrapper[K, V]) Get(key K) V {
 // Usage of this method is discouraged, as it breaks the thread-safety.
 // Lock will not be held while the reference is used, so it is possible
 // that the value of the element changes while the reference is used.
+// The reference must not be used after the next write through the Wrapper.
 func (w *Wrapper[K, V]) GetRef(key K) *V {
-	w.mutex.RLock()
-	defer w.mutex.RUnlock()
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
 
-	return w.m.GetRef(key)
+	ref := w.modifiable().GetRef(key)
+	w.referenceEscaped.Store(true)
+	return ref
 }
 
 // Set sets the value associated with the given key.
@@ -76,7 +132,7 @@ func (w *Wrapper[K, V]) Set(key K, value V) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.m.Set(key, value)
+	w.modifiable().Set(key, value)
 }
 
 // Update calculates and sets the new value
@@ -89,7 +145,7 @@ func (w *Wrapper[K, V]) Update(key K, updateFunction func(oldValue V) V) {
 	oldValue := w.m.Get(key)
 	newValue := updateFunction(oldValue)
 
-	w.m.Set(key, newValue)
+	w.modifiable().Set(key, newValue)
 }
 
 // UpdateRef updates the value at the given key
@@ -98,7 +154,7 @@ func (w *Wrapper[K, V]) UpdateRef(key K, updateFunction func(oldValue *V)) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	oldValue := w.m.GetRef(key)
+	oldValue := w.modifiable().GetRef(key)
 	updateFunction(oldValue)
 }
 
@@ -107,7 +163,7 @@ func (w *Wrapper[K, V]) Remove(key K) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.m.Remove(key)
+	w.modifiable().Remove(key)
 }
 
 // Clear removes all entries.
@@ -115,7 +171,7 @@ func (w *Wrapper[K, V]) Clear() {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	w.m.Clear()
+	w.modifiable().Clear()
 }
 
 // Clone returns a new Wrapper with
@@ -125,7 +181,7 @@ func (w *Wrapper[K, V]) Clone() maps.Map[K, V] {
 	defer w.mutex.RUnlock()
 
 	clonedMap := w.m.Clone()
-	return &Wrapper[K, V]{clonedMap, sync.RWMutex{}}
+	return From(clonedMap)
 }
 
 // Iterator returns a read-only iter.Iterator over the entries.
@@ -136,7 +192,10 @@ func (w *Wrapper[K, V]) Iterator() iter.Iterator[misc.Pair[K, V]] {
 // MapIterator returns an Iterator over the entries.
 // It can be used to modify the entries while iterating.
 func (w *Wrapper[K, V]) MapIterator() maps.Iterator[K, V] {
-	return Iterator[K, V]{w.m.MapIterator(), &w.mutex}
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return Iterator[K, V]{w.m.MapIterator(), w, w.version}
 }
 
 // Stream2 streams all entries.
@@ -144,6 +203,11 @@ func (w *Wrapper[K, V]) MapIterator() maps.Iterator[K, V] {
 // Updates to the underlying maps.Map
 // while streaming are not allowed.
 func (w *Wrapper[K, V]) Stream2(yield func(K, V) bool) {
+	if w.snapshotStreaming.Load() {
+		w.Snapshot().Stream2(yield)
+		return
+	}
+
 	w.mutex.RLock()
 	defer w.mutex.RUnlock()
 
@@ -152,6 +216,11 @@ func (w *Wrapper[K, V]) Stream2(yield func(K, V) bool) {
 
 // Keys streams the keys.
 func (w *Wrapper[K, V]) Keys(yield func(K) bool) {
+	if w.snapshotStreaming.Load() {
+		w.Snapshot().Keys(yield)
+		return
+	}
+
 	w.mutex.RLock()
 	defer w.mutex.RUnlock()
 
@@ -160,6 +229,11 @@ func (w *Wrapper[K, V]) Keys(yield func(K) bool) {
 
 // Values streams the values.
 func (w *Wrapper[K, V]) Values(yield func(V) bool) {
+	if w.snapshotStreaming.Load() {
+		w.Snapshot().Values(yield)
+		return
+	}
+
 	w.mutex.RLock()
 	defer w.mutex.RUnlock()
 
@@ -173,5 +247,5 @@ func (w *Wrapper[K, V]) Transaction(updateFunction func(m maps.Map[K, V])) {
 	w.mutex.Lock()
 	defer w.mutex.Unlock()
 
-	updateFunction(w.m)
+	updateFunction(w.modifiable())
 }
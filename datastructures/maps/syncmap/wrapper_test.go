@@ -0,0 +1,184 @@
+package syncmap
+
+import (
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+func TestSnapshotIsolation(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+	w.Set("a", 1)
+
+	snapshot := w.Snapshot()
+	w.Snapshot()
+	if w.version != 0 {
+		t.Error("map was copied without a write")
+	}
+
+	w.Set("a", 2)
+	w.Set("b", 3)
+	if w.version != 1 {
+		t.Errorf("map was copied %d times for writes after a snapshot", w.version)
+	}
+
+	if snapshot.Get("a") != 1 || snapshot.Contains("b") {
+		t.Error("snapshot reflects later writes")
+	}
+
+	if latest := w.Snapshot(); latest.Get("a") != 2 || latest.Size() != 2 {
+		t.Error("new snapshot does not reflect the writes")
+	}
+}
+
+func TestSnapshotAfterReference(t *testing.T) {
+	w := From[string, int](hashmap.New[string, int]())
+	w.Set("a", 1)
+
+	ref := w.GetRef("a")
+	snapshot := w.Snapshot()
+	*ref = 2
+
+	if snapshot.Get("a") != 1 {
+		t.Errorf("snapshot reflects a write through reference, has %d", snapshot.Get("a"))
+	}
+
+	w.Set("b", 3)
+	w.Snapshot()
+	if !w.shared.Load() {
+		t.Error("snapshot is not shared after a write")
+	}
+}
+
+func TestIteratorAfterSnapshot(t *testing.T) {
+	w := From[int, int](rbt.New[int, int]())
+	for i := range 6 {
+		w.Set(i, i)
+	}
+
+	var snapshot []int
+	var visited []int
+	for it := w.MapIterator(); it.Valid(); {
+		key := it.Key()
+		visited = append(visited, key)
+
+		if key == 2 {
+			snapshot = slices.Collect(w.Snapshot().Keys)
+		}
+
+		switch {
+		case key%2 == 0:
+			it.Remove()
+		case key == 3:
+			it.SetValue(30)
+			if it.Value() != 30 {
+				t.Errorf("Value() after SetValue() = %d", it.Value())
+			}
+			*it.ValueRef() += 3
+			it.Move()
+		default:
+			it.Move()
+		}
+	}
+
+	if !slices.Equal(visited, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("iterator visited %v", visited)
+	}
+
+	if !slices.Equal(snapshot, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("snapshot has keys %v", snapshot)
+	}
+
+	if keys := slices.Collect(w.Keys); !slices.Equal(keys, []int{1, 3, 5}) {
+		t.Errorf("wrapper has keys %v", keys)
+	}
+
+	if w.Get(3) != 33 {
+		t.Errorf("wrapper has %d at key 3", w.Get(3))
+	}
+}
+
+func TestSnapshotDoesNotBlockWriters(t *testing.T) {
+	w := From[int, int](hashmap.New[int, int]())
+	w.Set(0, 0)
+	w.SetSnapshotStreaming(true)
+
+	streaming := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range w.Stream2 {
+			close(streaming)
+			<-release
+		}
+	}()
+
+	<-streaming
+	for i := range 100 {
+		w.Set(i, i)
+	}
+	close(release)
+	<-done
+
+	if w.Size() != 100 {
+		t.Errorf("size = %d", w.Size())
+	}
+}
+
+func TestSnapshotStreaming(t *testing.T) {
+	w := From[int, int](hashmap.New[int, int]())
+	for i := range 10 {
+		w.Set(i, i)
+	}
+
+	w.SetSnapshotStreaming(true)
+
+	for key, value := range w.Stream2 {
+		w.Set(key, value*2)
+		w.Set(key+100, value)
+	}
+
+	if w.Size() != 20 || w.Get(5) != 10 {
+		t.Errorf("size = %d, value = %d", w.Size(), w.Get(5))
+	}
+}
+
+func TestConcurrentSnapshots(t *testing.T) {
+	w := From[int, int](hashmap.New[int, int]())
+
+	var wg sync.WaitGroup
+	for writer := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				w.Set(writer, i)
+			}
+		}()
+	}
+
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				for key, value := range w.Snapshot().Stream2 {
+					if key < 0 || key >= 4 || value < 0 {
+						t.Error("invalid entry in snapshot")
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for writer := range 4 {
+		if value := w.Snapshot().Get(writer); value != 999 {
+			t.Errorf("last snapshot has %d for writer %d", value, writer)
+		}
+	}
+}
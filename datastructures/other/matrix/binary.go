@@ -0,0 +1,89 @@
+package matrix
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"io"
+)
+
+const binaryKind = "matrix.Matrix"
+
+// EncodeBinary writes the Matrix to the writer
+// in the serial format, as its width followed by the rows.
+func (matrix *Matrix[T]) EncodeBinary(w io.Writer) error {
+	size := matrix.Size()
+
+	encoder, err := serial.NewEncoder(w, serial.Header{Kind: binaryKind, Length: size.Height + 1})
+	if err != nil {
+		return err
+	}
+
+	if err := encoder.Encode(size.Width); err != nil {
+		return err
+	}
+
+	for i := range size.Height {
+		if err := encoder.Encode(matrix.values[i*size.Width : (i+1)*size.Width]); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Close()
+}
+
+// DecodeBinary replaces the Matrix with
+// the one read from the reader in the serial format.
+//
+// SliceSizeMismatchError is returned if the rows have different lengths.
+func (matrix *Matrix[T]) DecodeBinary(r io.Reader) error {
+	decoder, err := serial.NewDecoderOfKind(r, binaryKind)
+	if err != nil {
+		return err
+	}
+
+	var width int
+	if err := decoder.Decode(&width); err != nil {
+		return err
+	}
+
+	if width < 0 {
+		return fmt.Errorf("matrix: invalid width %d", width)
+	}
+
+	var values []T
+	for decoder.More() {
+		var row []T
+		if err := decoder.Decode(&row); err != nil {
+			return err
+		}
+
+		if len(row) != width {
+			return SliceSizeMismatchError
+		}
+
+		values = append(values, row...)
+	}
+
+	*matrix = Matrix[T]{values, width}
+
+	return nil
+}
+
+// MarshalBinary encodes the Matrix in the serial format.
+func (matrix *Matrix[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := matrix.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Matrix from the serial format.
+func (matrix *Matrix[T]) UnmarshalBinary(data []byte) error {
+	return matrix.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Matrix in the serial format.
+func (matrix *Matrix[T]) GobEncode() ([]byte, error) { return matrix.MarshalBinary() }
+
+// GobDecode decodes the Matrix from the serial format.
+func (matrix *Matrix[T]) GobDecode(data []byte) error { return matrix.UnmarshalBinary(data) }
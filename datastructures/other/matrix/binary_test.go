@@ -0,0 +1,41 @@
+package matrix
+
+import (
+	"bytes"
+	"encoding/gob"
+	"github.com/djordje200179/extendedlibrary/datastructures/serial"
+	"slices"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	for _, rows := range [][][]int{{{1, 2, 3}, {4, 5, 6}}, {{1}, {2}}, {}, {{}, {}}} {
+		matrix := NewFromSlices(rows)
+
+		var buf bytes.Buffer
+		if err := gob.NewEncoder(&buf).Encode(matrix); err != nil {
+			t.Fatal(err)
+		}
+
+		decoded := New[int](Size{1, 1})
+		if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.Size() != matrix.Size() || !slices.Equal(decoded.values, matrix.values) {
+			t.Errorf("decoded %v into size %v and elements %v", rows, decoded.Size(), decoded.values)
+		}
+	}
+}
+
+func TestDecodeBinaryRowMismatch(t *testing.T) {
+	var buf bytes.Buffer
+	encoder, _ := serial.NewEncoder(&buf, serial.Header{Kind: binaryKind, Length: 3})
+	encoder.Encode(2)
+	encoder.Encode([]int{1, 2})
+	encoder.Encode([]int{3})
+
+	if err := New[int](Size{}).DecodeBinary(&buf); err != SliceSizeMismatchError {
+		t.Errorf("DecodeBinary() of rows with different lengths returned %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package matrix
+
+import "encoding/json"
+
+// MarshalJSON encodes the Matrix as a JSON array of rows.
+func (matrix *Matrix[T]) MarshalJSON() ([]byte, error) {
+	size := matrix.Size()
+
+	rows := make([][]T, size.Height)
+	for i := range rows {
+		rows[i] = matrix.values[i*size.Width : (i+1)*size.Width]
+	}
+
+	return json.Marshal(rows)
+}
+
+// UnmarshalJSON replaces the Matrix with
+// the one made of the rows of the JSON array.
+//
+// SliceSizeMismatchError is returned if the rows have different lengths.
+func (matrix *Matrix[T]) UnmarshalJSON(data []byte) error {
+	var rows [][]T
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if len(row) != len(rows[0]) {
+			return SliceSizeMismatchError
+		}
+	}
+
+	*matrix = *NewFromSlices(rows)
+
+	return nil
+}
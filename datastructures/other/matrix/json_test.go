@@ -0,0 +1,28 @@
+package matrix
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	matrix := NewFromSlices([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	data, err := json.Marshal(matrix)
+	if err != nil || string(data) != "[[1,2,3],[4,5,6]]" {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := New[int](Size{1, 1})
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Size() != matrix.Size() || decoded.Get(1, 2) != 6 {
+		t.Errorf("decoded matrix has size %v", decoded.Size())
+	}
+
+	if err := json.Unmarshal([]byte("[[1,2],[3]]"), decoded); err != SliceSizeMismatchError {
+		t.Errorf("Unmarshal() of ragged rows returned %v", err)
+	}
+}
@@ -127,5 +127,11 @@ func DotMultiply[T math.Real](matrices ...*Matrix[T]) *Matrix[T] {
 
 // Negate negates the Matrix and returns the resulting Matrix.
 func Negate[T math.Real](matrix *Matrix[T]) *Matrix[T] {
-	return ScalarMultiply[T](matrix, -1)
+	result := New[T](matrix.Size())
+
+	for i := range result.Size().Elements() {
+		result.values[i] = -matrix.values[i]
+	}
+
+	return result
 }
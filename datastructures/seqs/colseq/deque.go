@@ -3,8 +3,8 @@ package colseq
 import (
 	"errors"
 	"github.com/djordje200179/extendedlibrary/datastructures/cols"
-	"github.com/djordje200179/extendedlibrary/datastructures/cols/array"
 	"github.com/djordje200179/extendedlibrary/datastructures/cols/linklist"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/ringbuf"
 )
 
 // Deque is a seqs.Deque implemented
@@ -14,8 +14,8 @@ type Deque[T any] struct {
 }
 
 // NewArrayDeque creates a new Deque
-// backed by an array.Array.
-func NewArrayDeque[T any]() Deque[T] { return Deque[T]{array.New[T]()} }
+// backed by a ringbuf.Buffer.
+func NewArrayDeque[T any]() Deque[T] { return Deque[T]{ringbuf.New[T]()} }
 
 // NewLinkedListDeque creates a new Deque
 // backed by a linklist.List.
@@ -29,7 +29,7 @@ func From[T any](coll cols.Collection[T]) Deque[T] { return Deque[T]{coll} }
 func (deque Deque[T]) Empty() bool { return deque.coll.Size() == 0 }
 
 // PushFront adds the given value to the front.
-func (deque Deque[T]) PushFront(value T) { deque.coll.Insert(0, value) }
+func (deque Deque[T]) PushFront(value T) { deque.coll.Prepend(value) }
 
 // TryPushFront adds the given value to the front
 // and is always successful.
@@ -0,0 +1,35 @@
+package colseq
+
+import "testing"
+
+func TestArrayDeque(t *testing.T) {
+	deque := NewArrayDeque[int]()
+
+	for i := range 20 {
+		deque.PushBack(i)
+		deque.PushFront(-i)
+	}
+
+	for i := 19; i >= 0; i-- {
+		if front := deque.PopFront(); front != -i {
+			t.Fatalf("PopFront() = %d, expected %d", front, -i)
+		}
+
+		if back := deque.PopBack(); back != i {
+			t.Fatalf("PopBack() = %d, expected %d", back, i)
+		}
+	}
+
+	if !deque.Empty() {
+		t.Error("deque is not empty")
+	}
+
+	if _, ok := deque.TryPopFront(); ok {
+		t.Error("TryPopFront() succeeded on an empty deque")
+	}
+
+	deque.PushFront(1)
+	if deque.PeekFront() != 1 || deque.PeekBack() != 1 {
+		t.Error("single element is not at both ends")
+	}
+}
@@ -0,0 +1,43 @@
+package pq
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+)
+
+// MarshalJSON encodes the Queue as a JSON array
+// whose elements are in the order they would be popped.
+func (pq *Queue[T]) MarshalJSON() ([]byte, error) {
+	sorted := slices.Clone(pq.slice)
+	if sorted == nil {
+		sorted = []T{}
+	}
+
+	slices.SortStableFunc(sorted, pq.cmp)
+
+	return json.Marshal(sorted)
+}
+
+// UnmarshalJSON replaces the elements of the Queue
+// with the elements of the JSON array.
+//
+// The Queue must already have a comparator,
+// so it should be created with New.
+func (pq *Queue[T]) UnmarshalJSON(data []byte) error {
+	if pq.cmp == nil {
+		return errors.New("pq: cannot unmarshal into a queue without a comparator")
+	}
+
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	pq.slice = pq.slice[:0]
+	for _, element := range elements {
+		pq.PushBack(element)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package pq
+
+import (
+	"cmp"
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	queue := New[int](cmp.Compare[int])
+	for _, value := range []int{5, 1, 4, 2, 3} {
+		queue.PushBack(value)
+	}
+
+	data, err := json.Marshal(queue)
+	if err != nil || string(data) != "[1,2,3,4,5]" {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := New[int](func(first, second int) int { return cmp.Compare(second, first) })
+	decoded.PushBack(10)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []int{5, 4, 3, 2, 1} {
+		if value := decoded.PopFront(); value != expected {
+			t.Errorf("PopFront() = %d, expected %d", value, expected)
+		}
+	}
+
+	if !decoded.Empty() {
+		t.Error("decoded queue has extra elements")
+	}
+
+	if err := json.Unmarshal(data, new(Queue[int])); err == nil {
+		t.Error("Unmarshal() into a queue without a comparator succeeded")
+	}
+}
@@ -0,0 +1,139 @@
+package serial
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+)
+
+// Decoder reads a datastructure from a stream element by element.
+//
+// If the reader doesn't implement io.ByteReader,
+// it is buffered, so the Decoder may read past the end of the data.
+type Decoder struct {
+	gob *gob.Decoder
+
+	header    Header
+	remaining int
+}
+
+type byteReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// NewDecoder reads the Header from the reader
+// and returns a Decoder for the elements that follow it.
+func NewDecoder(r io.Reader) (*Decoder, error) {
+	buffered, ok := r.(byteReader)
+	if !ok {
+		buffered = bufio.NewReader(r)
+	}
+
+	header, err := readHeader(buffered)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := &Decoder{
+		gob:       gob.NewDecoder(buffered),
+		header:    header,
+		remaining: header.Length,
+	}
+
+	return decoder, nil
+}
+
+// NewDecoderOfKind is like NewDecoder, but returns KindMismatchError
+// if the data contains a datastructure of a different kind.
+func NewDecoderOfKind(r io.Reader, kind string) (*Decoder, error) {
+	decoder, err := NewDecoder(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if decoder.header.Kind != kind {
+		return nil, KindMismatchError{Expected: kind, Actual: decoder.header.Kind}
+	}
+
+	return decoder, nil
+}
+
+// Header returns the Header of the data.
+func (d *Decoder) Header() Header {
+	return d.header
+}
+
+// More returns true if there are elements left to decode.
+func (d *Decoder) More() bool {
+	return d.remaining > 0
+}
+
+// Decode reads the next element into the value pointed to by ptr.
+// Returns io.EOF if all elements were already read.
+func (d *Decoder) Decode(ptr any) error {
+	if d.remaining == 0 {
+		return io.EOF
+	}
+
+	if err := d.gob.Decode(ptr); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return err
+	}
+
+	d.remaining--
+
+	return nil
+}
+
+// DecodeStream reads the elements of a datastructure
+// of the specified kind and passes them to add.
+func DecodeStream[T any](r io.Reader, kind string, add func(value T)) error {
+	decoder, err := NewDecoderOfKind(r, kind)
+	if err != nil {
+		return err
+	}
+
+	for decoder.More() {
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+
+		add(value)
+	}
+
+	return nil
+}
+
+// DecodeStream2 reads the pairs of a datastructure
+// of the specified kind and passes them to add.
+func DecodeStream2[K, V any](r io.Reader, kind string, add func(key K, value V)) error {
+	decoder, err := NewDecoderOfKind(r, kind)
+	if err != nil {
+		return err
+	}
+
+	if decoder.header.Length%2 != 0 {
+		return ErrInvalidHeader
+	}
+
+	for decoder.More() {
+		var key K
+		if err := decoder.Decode(&key); err != nil {
+			return err
+		}
+
+		var value V
+		if err := decoder.Decode(&value); err != nil {
+			return err
+		}
+
+		add(key, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package serial
+
+import (
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+// Encoder writes a datastructure to a stream element by element,
+// so the datastructure doesn't have to be materialized in memory.
+type Encoder struct {
+	gob *gob.Encoder
+
+	remaining int
+}
+
+// NewEncoder writes the specified Header to the writer
+// and returns an Encoder for the elements that follow it.
+func NewEncoder(w io.Writer, header Header) (*Encoder, error) {
+	if err := writeHeader(w, header); err != nil {
+		return nil, err
+	}
+
+	encoder := &Encoder{
+		gob:       gob.NewEncoder(w),
+		remaining: header.Length,
+	}
+
+	return encoder, nil
+}
+
+// Encode writes the next element.
+// Returns an error if all elements announced in the Header were already written.
+func (e *Encoder) Encode(value any) error {
+	if e.remaining == 0 {
+		return fmt.Errorf("serial: more elements than announced in the header")
+	}
+
+	if err := e.gob.Encode(value); err != nil {
+		return err
+	}
+
+	e.remaining--
+
+	return nil
+}
+
+// Close checks that all elements announced in the Header were written.
+// It doesn't close the underlying writer.
+func (e *Encoder) Close() error {
+	if e.remaining != 0 {
+		return fmt.Errorf("serial: %d elements announced in the header were not written", e.remaining)
+	}
+
+	return nil
+}
+
+// EncodeStream writes a Header with the specified kind and length
+// and then the streamed elements.
+func EncodeStream[T any](w io.Writer, kind string, length int, stream func(yield func(T) bool)) error {
+	encoder, err := NewEncoder(w, Header{kind, length})
+	if err != nil {
+		return err
+	}
+
+	for value := range stream {
+		if err = encoder.Encode(value); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Close()
+}
+
+// EncodeStream2 writes a Header with the specified kind and length
+// and then the streamed pairs, each one as two consecutive elements.
+// The length is the number of pairs.
+func EncodeStream2[K, V any](w io.Writer, kind string, length int, stream func(yield func(K, V) bool)) error {
+	encoder, err := NewEncoder(w, Header{kind, 2 * length})
+	if err != nil {
+		return err
+	}
+
+	for key, value := range stream {
+		if err = encoder.Encode(key); err != nil {
+			return err
+		}
+
+		if err = encoder.Encode(value); err != nil {
+			return err
+		}
+	}
+
+	return encoder.Close()
+}
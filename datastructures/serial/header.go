@@ -0,0 +1,103 @@
+// Package serial implements the compact binary format
+// used for persisting the datastructures.
+//
+// Every encoded datastructure starts with a versioned Header,
+// followed by its elements encoded with encoding/gob one by one.
+package serial
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Version is the version of the format written by the Encoder.
+const Version uint8 = 1
+
+var magic = [4]byte{'E', 'X', 'D', 'S'}
+
+// Header describes the encoded datastructure.
+type Header struct {
+	Kind   string // Kind is the name of the datastructure, like "rbt.Tree".
+	Length int    // Length is the number of elements that follow the header.
+}
+
+// ErrInvalidHeader is an error that occurs
+// when the data doesn't start with a valid header.
+var ErrInvalidHeader = errors.New("serial: invalid header")
+
+// UnsupportedVersionError is an error that occurs
+// when the data was written with a newer version of the format.
+type UnsupportedVersionError struct {
+	Version uint8 // Version is the version of the data.
+}
+
+// Error returns the error message.
+func (e UnsupportedVersionError) Error() string {
+	return fmt.Sprintf("serial: unsupported version %d, latest is %d", e.Version, Version)
+}
+
+// KindMismatchError is an error that occurs
+// when the data contains a different datastructure
+// than the one it is decoded into.
+type KindMismatchError struct {
+	Expected, Actual string
+}
+
+// Error returns the error message.
+func (e KindMismatchError) Error() string {
+	return fmt.Sprintf("serial: cannot decode %s into %s", e.Actual, e.Expected)
+}
+
+func writeHeader(w io.Writer, header Header) error {
+	buf := make([]byte, 0, len(magic)+1+2*binary.MaxVarintLen64+len(header.Kind))
+	buf = append(buf, magic[:]...)
+	buf = append(buf, Version)
+	buf = binary.AppendUvarint(buf, uint64(len(header.Kind)))
+	buf = append(buf, header.Kind...)
+	buf = binary.AppendUvarint(buf, uint64(header.Length))
+
+	_, err := w.Write(buf)
+	return err
+}
+
+func readHeader(r io.ByteReader) (Header, error) {
+	var prefix [len(magic) + 1]byte
+	for i := range prefix {
+		b, err := r.ReadByte()
+		if err != nil {
+			return Header{}, ErrInvalidHeader
+		}
+
+		prefix[i] = b
+	}
+
+	if [4]byte(prefix[:4]) != magic {
+		return Header{}, ErrInvalidHeader
+	}
+
+	if version := prefix[4]; version > Version || version == 0 {
+		return Header{}, UnsupportedVersionError{version}
+	}
+
+	kindLength, err := binary.ReadUvarint(r)
+	if err != nil || kindLength > 256 {
+		return Header{}, ErrInvalidHeader
+	}
+
+	kind := make([]byte, kindLength)
+	for i := range kind {
+		if kind[i], err = r.ReadByte(); err != nil {
+			return Header{}, ErrInvalidHeader
+		}
+	}
+
+	length, err := binary.ReadUvarint(r)
+	if err != nil || length > math.MaxInt {
+		return Header{}, ErrInvalidHeader
+	}
+
+	return Header{string(kind), int(length)}, nil
+}
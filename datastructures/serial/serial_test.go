@@ -0,0 +1,163 @@
+package serial
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+func encodeHeader(t *testing.T, header Header) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := writeHeader(&buf, header); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestHeaderRoundTrip(t *testing.T) {
+	for _, header := range []Header{{"rbt.Tree", 10}, {"", 0}, {"array.Array", 1 << 40}} {
+		decoder, err := NewDecoder(bytes.NewReader(encodeHeader(t, header)))
+		if err != nil {
+			t.Fatalf("NewDecoder() for %v returned %v", header, err)
+		}
+
+		if decoder.Header() != header {
+			t.Errorf("Header() = %v, expected %v", decoder.Header(), header)
+		}
+	}
+}
+
+func TestUnsupportedVersion(t *testing.T) {
+	for _, version := range []uint8{0, Version + 1} {
+		data := encodeHeader(t, Header{"array.Array", 0})
+		data[len(magic)] = version
+
+		var versionErr UnsupportedVersionError
+		if _, err := NewDecoder(bytes.NewReader(data)); !errors.As(err, &versionErr) || versionErr.Version != version {
+			t.Errorf("NewDecoder() of version %d returned %v", version, err)
+		}
+	}
+}
+
+func TestKindMismatch(t *testing.T) {
+	data := encodeHeader(t, Header{"array.Array", 0})
+
+	var kindErr KindMismatchError
+	if _, err := NewDecoderOfKind(bytes.NewReader(data), "linklist.List"); !errors.As(err, &kindErr) {
+		t.Fatalf("NewDecoderOfKind() returned %v", err)
+	}
+
+	if kindErr.Expected != "linklist.List" || kindErr.Actual != "array.Array" {
+		t.Errorf("KindMismatchError = %+v", kindErr)
+	}
+
+	if _, err := NewDecoderOfKind(bytes.NewReader(data), "array.Array"); err != nil {
+		t.Errorf("NewDecoderOfKind() of the right kind returned %v", err)
+	}
+}
+
+func TestTruncatedInput(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeStream(&buf, "array.Array", 3, slices.Values([]string{"a", "b", "c"})); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	headerSize := len(encodeHeader(t, Header{"array.Array", 3}))
+
+	for size := range headerSize {
+		if _, err := NewDecoder(bytes.NewReader(data[:size])); err != ErrInvalidHeader {
+			t.Errorf("NewDecoder() of %d bytes returned %v", size, err)
+		}
+	}
+
+	corrupted := slices.Clone(data)
+	corrupted[0] = 'X'
+	if _, err := NewDecoder(bytes.NewReader(corrupted)); err != ErrInvalidHeader {
+		t.Errorf("NewDecoder() with a wrong magic returned %v", err)
+	}
+
+	for size := headerSize; size < len(data); size++ {
+		err := DecodeStream(bytes.NewReader(data[:size]), "array.Array", func(string) {})
+		if err == nil {
+			t.Errorf("DecodeStream() of %d bytes succeeded", size)
+		}
+	}
+
+	decoder, _ := NewDecoder(bytes.NewReader(encodeHeader(t, Header{"array.Array", 1})))
+	var value string
+	if err := decoder.Decode(&value); err != io.ErrUnexpectedEOF {
+		t.Errorf("Decode() of a missing element returned %v", err)
+	}
+
+	decoder, _ = NewDecoder(bytes.NewReader(data))
+	for decoder.More() {
+		decoder.Decode(&value)
+	}
+	if err := decoder.Decode(&value); err != io.EOF {
+		t.Errorf("Decode() after the last element returned %v", err)
+	}
+}
+
+func TestEncoderCount(t *testing.T) {
+	encoder, err := NewEncoder(io.Discard, Header{"array.Array", 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encoder.Encode(1)
+	if err := encoder.Close(); err == nil {
+		t.Error("Close() after fewer elements than announced succeeded")
+	}
+
+	encoder.Encode(2)
+	if err := encoder.Encode(3); err == nil {
+		t.Error("Encode() of more elements than announced succeeded")
+	}
+	if err := encoder.Close(); err != nil {
+		t.Errorf("Close() returned %v", err)
+	}
+
+	values := slices.Values([]int{1, 2, 3})
+	if err := EncodeStream(io.Discard, "array.Array", 2, values); err == nil {
+		t.Error("EncodeStream() of a longer stream succeeded")
+	}
+	if err := EncodeStream(io.Discard, "array.Array", 4, values); err == nil {
+		t.Error("EncodeStream() of a shorter stream succeeded")
+	}
+}
+
+func TestStream2RoundTrip(t *testing.T) {
+	keys, values := []string{"a", "b", "c"}, []int{1, 2, 3}
+
+	var buf bytes.Buffer
+	err := EncodeStream2(&buf, "rbt.Tree", len(keys), func(yield func(string, int) bool) {
+		for i := range keys {
+			if !yield(keys[i], values[i]) {
+				return
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decodedKeys []string
+	var decodedValues []int
+	err = DecodeStream2(&buf, "rbt.Tree", func(key string, value int) {
+		decodedKeys = append(decodedKeys, key)
+		decodedValues = append(decodedValues, value)
+	})
+	if err != nil || !slices.Equal(decodedKeys, keys) || !slices.Equal(decodedValues, values) {
+		t.Errorf("DecodeStream2() = %v, %v, %v", decodedKeys, decodedValues, err)
+	}
+
+	odd := encodeHeader(t, Header{"rbt.Tree", 3})
+	if err := DecodeStream2(bytes.NewReader(odd), "rbt.Tree", func(string, int) {}); err != ErrInvalidHeader {
+		t.Errorf("DecodeStream2() with an odd length returned %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package bitset
+
+import (
+	"bytes"
+	"github.com/djordje200179/extendedlibrary/datastructures/cols/bitarray"
+	"io"
+)
+
+// EncodeBinary writes the Set to the writer in the serial format.
+// It is encoded the same way as its underlying bitarray.Array.
+func (s *Set) EncodeBinary(w io.Writer) error {
+	return s.arr.EncodeBinary(w)
+}
+
+// DecodeBinary replaces the range and the elements
+// of the Set with the ones read from the reader in the serial format.
+func (s *Set) DecodeBinary(r io.Reader) error {
+	arr := bitarray.New()
+	if err := arr.DecodeBinary(r); err != nil {
+		return err
+	}
+
+	*s = *FromArray(arr)
+
+	return nil
+}
+
+// MarshalBinary encodes the Set in the serial format.
+func (s *Set) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	err := s.EncodeBinary(&buf)
+	return buf.Bytes(), err
+}
+
+// UnmarshalBinary decodes the Set from the serial format.
+func (s *Set) UnmarshalBinary(data []byte) error {
+	return s.DecodeBinary(bytes.NewReader(data))
+}
+
+// GobEncode encodes the Set in the serial format.
+func (s *Set) GobEncode() ([]byte, error) { return s.MarshalBinary() }
+
+// GobDecode decodes the Set from the serial format.
+func (s *Set) GobDecode(data []byte) error { return s.UnmarshalBinary(data) }
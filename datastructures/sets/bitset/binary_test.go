@@ -0,0 +1,38 @@
+package bitset
+
+import (
+	"bytes"
+	"encoding/gob"
+	"slices"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	set := New(20)
+	for _, value := range []int{0, 7, 8, 19} {
+		set.Add(value)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(set); err != nil {
+		t.Fatal(err)
+	}
+
+	decoded := New(3)
+	if err := gob.NewDecoder(&buf).Decode(decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := slices.Collect(decoded.Stream); !slices.Equal(got, []int{0, 7, 8, 19}) || decoded.Size() != 4 {
+		t.Errorf("decoded elements are %v", got)
+	}
+
+	if decoded.Array().Size() != 20 {
+		t.Errorf("decoded range has size %d", decoded.Array().Size())
+	}
+
+	decoded.Add(18)
+	if !decoded.Contains(18) || decoded.Size() != 5 {
+		t.Error("decoded set can't be modified")
+	}
+}
@@ -0,0 +1,52 @@
+package bitset
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type jsonSet struct {
+	Size     int   `json:"size"`
+	Elements []int `json:"elements"`
+}
+
+// MarshalJSON encodes the Set as a JSON object
+// with the size of the range and the sorted elements.
+func (s *Set) MarshalJSON() ([]byte, error) {
+	encoded := jsonSet{
+		Size:     s.arr.Size(),
+		Elements: make([]int, 0, s.elements),
+	}
+
+	for element := range s.Stream {
+		encoded.Elements = append(encoded.Elements, element)
+	}
+
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON replaces the range and the elements
+// of the Set with the ones from the JSON object.
+func (s *Set) UnmarshalJSON(data []byte) error {
+	var decoded jsonSet
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+
+	if decoded.Size < 0 {
+		return fmt.Errorf("bitset: invalid size %d", decoded.Size)
+	}
+
+	set := New(decoded.Size)
+	for _, element := range decoded.Elements {
+		if element < 0 || element >= decoded.Size {
+			return fmt.Errorf("bitset: element %d is out of range 0..%d", element, decoded.Size-1)
+		}
+
+		set.Add(element)
+	}
+
+	*s = *set
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package bitset
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	set := New(10)
+	set.Add(7)
+	set.Add(2)
+
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != `{"size":10,"elements":[2,7]}` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := New(3)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Array().Size() != 10 || !slices.Equal(slices.Collect(decoded.Stream), []int{2, 7}) {
+		t.Errorf("decoded set has size %d and elements %v", decoded.Array().Size(), slices.Collect(decoded.Stream))
+	}
+}
+
+func TestJSONOutOfRange(t *testing.T) {
+	set := New(3)
+	set.Add(1)
+
+	for _, data := range []string{`{"size":5,"elements":[5]}`, `{"size":5,"elements":[-1]}`, `{"size":-1}`} {
+		if err := json.Unmarshal([]byte(data), set); err == nil {
+			t.Errorf("Unmarshal() of %s succeeded", data)
+		}
+	}
+
+	if set.Array().Size() != 3 || !set.Contains(1) {
+		t.Error("failed decoding changed the set")
+	}
+}
@@ -0,0 +1,40 @@
+package mapset
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// MarshalJSON encodes the Set as a JSON array
+// whose elements are in the order of the underlying map.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	elements := make([]T, 0, s.Size())
+	for element := range s.Stream {
+		elements = append(elements, element)
+	}
+
+	return json.Marshal(elements)
+}
+
+// UnmarshalJSON replaces the elements of the Set
+// with the elements of the JSON array.
+//
+// The Set must already have an underlying map,
+// so it should be created with one of the constructors.
+func (s Set[T]) UnmarshalJSON(data []byte) error {
+	if s.m == nil {
+		return errors.New("mapset: cannot unmarshal into a set without a map")
+	}
+
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+
+	s.Clear()
+	for _, element := range elements {
+		s.Add(element)
+	}
+
+	return nil
+}
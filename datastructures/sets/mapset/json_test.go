@@ -0,0 +1,37 @@
+package mapset
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	set := NewTreeSet[int]()
+	for _, element := range []int{3, 1, 2} {
+		set.Add(element)
+	}
+
+	data, err := json.Marshal(set)
+	if err != nil || string(data) != "[1,2,3]" {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+
+	decoded := NewHashSet[int]()
+	decoded.Add(10)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	elements := slices.Sorted(decoded.Stream)
+	if !slices.Equal(elements, []int{1, 2, 3}) {
+		t.Errorf("decoded elements are %v", elements)
+	}
+}
+
+func TestJSONWithoutMap(t *testing.T) {
+	var set Set[int]
+	if err := json.Unmarshal([]byte("[1]"), &set); err == nil {
+		t.Error("Unmarshal() into a set without a map succeeded")
+	}
+}
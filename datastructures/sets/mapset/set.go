@@ -5,8 +5,10 @@ import (
 	"github.com/djordje200179/extendedlibrary/datastructures/iter"
 	"github.com/djordje200179/extendedlibrary/datastructures/maps"
 	"github.com/djordje200179/extendedlibrary/datastructures/maps/hashmap"
+	"github.com/djordje200179/extendedlibrary/datastructures/maps/openmap"
 	"github.com/djordje200179/extendedlibrary/datastructures/maps/rbt"
 	"github.com/djordje200179/extendedlibrary/datastructures/sets"
+	"github.com/djordje200179/extendedlibrary/misc/functions/hashing"
 )
 
 type empty struct{}
@@ -22,6 +24,13 @@ func NewHashSet[T comparable]() Set[T] {
 	return FromMap[T](hashmap.New[T, empty]())
 }
 
+// NewHashSetWithHasher creates a new hash set that hashes and
+// compares elements with the given hashing.Hasher and hashing.Equaler.
+// Elements don't need to be comparable.
+func NewHashSetWithHasher[T any](hasher hashing.Hasher[T], equaler hashing.Equaler[T]) Set[T] {
+	return FromMap[T](openmap.New[T, empty](hasher, equaler))
+}
+
 // NewTreeSet creates a new tree set for ordered types.
 func NewTreeSet[T cmp.Ordered]() Set[T] {
 	return FromMap[T](rbt.New[T, empty]())
@@ -0,0 +1,117 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+// DefaultHasher returns a Hasher for comparable values
+// that is consistent with the == operator.
+//
+// Values of basic types are hashed directly, while values of other
+// types are hashed field by field through reflection, which is slower.
+// A dedicated Hasher should be written for such types if speed matters.
+func DefaultHasher[T comparable]() Hasher[T] {
+	hashString := StringHasher()
+	hashUint := func(value uint64) uint64 { return Combine(value) }
+
+	return func(value T) uint64 {
+		switch v := any(value).(type) {
+		case string:
+			return hashString(v)
+		case int:
+			return hashUint(uint64(v))
+		case int8:
+			return hashUint(uint64(v))
+		case int16:
+			return hashUint(uint64(v))
+		case int32:
+			return hashUint(uint64(v))
+		case int64:
+			return hashUint(uint64(v))
+		case uint:
+			return hashUint(uint64(v))
+		case uint8:
+			return hashUint(uint64(v))
+		case uint16:
+			return hashUint(uint64(v))
+		case uint32:
+			return hashUint(uint64(v))
+		case uint64:
+			return hashUint(v)
+		case uintptr:
+			return hashUint(uint64(v))
+		case float32:
+			return hashUint(math.Float64bits(float64(v) + 0))
+		case float64:
+			return hashUint(math.Float64bits(v + 0))
+		case bool:
+			if v {
+				return hashUint(1)
+			}
+			return hashUint(0)
+		default:
+			var hash maphash.Hash
+			hash.SetSeed(seed)
+			writeValue(&hash, reflect.ValueOf(&value).Elem())
+
+			return hash.Sum64()
+		}
+	}
+}
+
+func writeUint(hash *maphash.Hash, value uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], value)
+	hash.Write(buf[:])
+}
+
+// writeValue writes the parts of the value that the == operator compares.
+// Floating-point zeros are normalized, pointers are written as addresses
+// and interfaces are written as their dynamic values.
+func writeValue(hash *maphash.Hash, value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Bool:
+		if value.Bool() {
+			writeUint(hash, 1)
+		} else {
+			writeUint(hash, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint(hash, uint64(value.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint(hash, value.Uint())
+	case reflect.Float32, reflect.Float64:
+		writeUint(hash, math.Float64bits(value.Float()+0))
+	case reflect.Complex64, reflect.Complex128:
+		writeUint(hash, math.Float64bits(real(value.Complex())+0))
+		writeUint(hash, math.Float64bits(imag(value.Complex())+0))
+	case reflect.String:
+		writeUint(hash, uint64(value.Len()))
+		hash.WriteString(value.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		writeUint(hash, uint64(value.Pointer()))
+	case reflect.Array:
+		for i := range value.Len() {
+			writeValue(hash, value.Index(i))
+		}
+	case reflect.Struct:
+		valueType := value.Type()
+		for i := range value.NumField() {
+			if valueType.Field(i).Name != "_" {
+				writeValue(hash, value.Field(i))
+			}
+		}
+	case reflect.Interface:
+		if value.IsNil() {
+			writeUint(hash, 0)
+		} else {
+			writeUint(hash, 1)
+			writeValue(hash, value.Elem())
+		}
+	default:
+		panic("hashing: value of type " + value.Type().String() + " is not comparable")
+	}
+}
@@ -0,0 +1,29 @@
+package hashing
+
+import (
+	"bytes"
+	"slices"
+)
+
+// Equaler is a function that checks if two values are equal.
+type Equaler[T any] func(first, second T) bool
+
+// DefaultEqualer returns an Equaler that
+// compares values with the == operator.
+func DefaultEqualer[T comparable]() Equaler[T] {
+	return func(first, second T) bool { return first == second }
+}
+
+// BytesEqualer returns an Equaler for byte slices
+// that compares their contents.
+func BytesEqualer() Equaler[[]byte] {
+	return bytes.Equal
+}
+
+// SliceEqualer returns an Equaler for slices
+// that compares their elements in order.
+func SliceEqualer[T any](elementEqualer Equaler[T]) Equaler[[]T] {
+	return func(first, second []T) bool {
+		return slices.EqualFunc(first, second, elementEqualer)
+	}
+}
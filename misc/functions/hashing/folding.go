@@ -0,0 +1,41 @@
+package hashing
+
+import (
+	"hash/maphash"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func foldRune(r rune) rune {
+	folded := r
+	for curr := unicode.SimpleFold(r); curr != r; curr = unicode.SimpleFold(curr) {
+		folded = min(folded, curr)
+	}
+
+	return folded
+}
+
+// FoldedStringHasher returns a Hasher for strings
+// that is consistent with FoldedStringEqualer.
+func FoldedStringHasher() Hasher[string] {
+	return func(value string) uint64 {
+		var hash maphash.Hash
+		hash.SetSeed(seed)
+
+		var buf [utf8.UTFMax]byte
+		for _, r := range value {
+			n := utf8.EncodeRune(buf[:], foldRune(r))
+			hash.Write(buf[:n])
+		}
+
+		return hash.Sum64()
+	}
+}
+
+// FoldedStringEqualer returns an Equaler for strings
+// that compares them case-insensitively,
+// under Unicode case-folding.
+func FoldedStringEqualer() Equaler[string] {
+	return strings.EqualFold
+}
@@ -0,0 +1,51 @@
+package hashing
+
+import "hash/maphash"
+
+// Hasher is a function that calculates the hash of a value.
+//
+// Values that are equal according to the
+// accompanying Equaler must have the same hash.
+type Hasher[T any] func(value T) uint64
+
+var seed = maphash.MakeSeed()
+
+// Combine mixes multiple hashes into one.
+// It is useful for writing a Hasher for composite values.
+func Combine(hashes ...uint64) uint64 {
+	var hash maphash.Hash
+	hash.SetSeed(seed)
+
+	for _, value := range hashes {
+		writeUint(&hash, value)
+	}
+
+	return hash.Sum64()
+}
+
+// StringHasher returns a Hasher for strings.
+func StringHasher() Hasher[string] {
+	return func(value string) uint64 { return maphash.String(seed, value) }
+}
+
+// BytesHasher returns a Hasher for byte slices
+// that hashes their contents.
+func BytesHasher() Hasher[[]byte] {
+	return func(value []byte) uint64 { return maphash.Bytes(seed, value) }
+}
+
+// SliceHasher returns a Hasher for slices
+// that combines hashes of their elements.
+func SliceHasher[T any](elementHasher Hasher[T]) Hasher[[]T] {
+	return func(value []T) uint64 {
+		var hash maphash.Hash
+		hash.SetSeed(seed)
+
+		writeUint(&hash, uint64(len(value)))
+		for _, elem := range value {
+			writeUint(&hash, elementHasher(elem))
+		}
+
+		return hash.Sum64()
+	}
+}
@@ -0,0 +1,60 @@
+package hashing
+
+import (
+	"math"
+	"testing"
+)
+
+type point struct {
+	X, Y float64
+	_    int
+}
+
+type entry struct {
+	name  string
+	ptr   *int
+	value any
+	pos   point
+}
+
+func TestDefaultHasherConsistency(t *testing.T) {
+	first, second := 1, 1
+
+	equal := [][2]entry{
+		{{name: "zero", pos: point{X: 0}}, {name: "zero", pos: point{X: math.Copysign(0, -1)}}},
+		{{ptr: &first}, {ptr: &first}},
+		{{value: 5}, {value: 5}},
+		{{value: point{1, 2, 0}}, {value: point{1, 2, 0}}},
+		{{value: nil}, {value: nil}},
+		{{value: math.Copysign(0, -1)}, {value: 0.0}},
+	}
+
+	hasher := DefaultHasher[entry]()
+	for _, pair := range equal {
+		if pair[0] != pair[1] {
+			t.Fatalf("%v and %v are not equal", pair[0], pair[1])
+		}
+
+		if hasher(pair[0]) != hasher(pair[1]) {
+			t.Errorf("equal values %v and %v have different hashes", pair[0], pair[1])
+		}
+	}
+
+	if hasher(entry{ptr: &first}) == hasher(entry{ptr: &second}) {
+		t.Error("different pointers have the same hash")
+	}
+
+	if hasher(entry{name: "a", value: "b"}) == hasher(entry{name: "ab"}) {
+		t.Error("strings from different fields are mixed together")
+	}
+}
+
+func TestCombineDoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		Combine(1, 2, 3)
+	})
+
+	if allocs != 0 {
+		t.Errorf("Combine() allocates %.0f times", allocs)
+	}
+}
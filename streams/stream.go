@@ -17,6 +17,14 @@ func From[T any](streamer Streamer[T]) Stream[T] {
 	return streamer.Stream
 }
 
+type ReverseStreamer[T any] interface {
+	ReverseStream(yield func(T) bool)
+}
+
+func FromReverse[T any](streamer ReverseStreamer[T]) Stream[T] {
+	return streamer.ReverseStream
+}
+
 func FromSlice[T any](slice []T) Stream[T] {
 	return func(yield func(T) bool) {
 		for _, elem := range slice {
@@ -12,6 +12,14 @@ func From2[K, V any](streamer Streamer2[K, V]) Stream2[K, V] {
 	return streamer.Stream2
 }
 
+type ReverseStreamer2[K, V any] interface {
+	ReverseStream2(yield func(K, V) bool)
+}
+
+func FromReverse2[K, V any](streamer ReverseStreamer2[K, V]) Stream2[K, V] {
+	return streamer.ReverseStream2
+}
+
 func FromMap[K comparable, V any](m map[K]V) Stream2[K, V] {
 	return func(yield func(K, V) bool) {
 		for k, v := range m {
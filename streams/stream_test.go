@@ -7,3 +7,48 @@ func TestEnumerate(t *testing.T) {
 		t.Log(i, val)
 	}
 }
+
+type reversible []int
+
+func (r reversible) ReverseStream(yield func(int) bool) {
+	for i := len(r) - 1; i >= 0; i-- {
+		if !yield(r[i]) {
+			return
+		}
+	}
+}
+
+func (r reversible) ReverseStream2(yield func(int, int) bool) {
+	for i := len(r) - 1; i >= 0; i-- {
+		if !yield(i, r[i]) {
+			return
+		}
+	}
+}
+
+func TestFromReverse(t *testing.T) {
+	var values []int
+	for value := range FromReverse(reversible{1, 2, 3}) {
+		values = append(values, value)
+		if value == 2 {
+			break
+		}
+	}
+
+	if len(values) != 2 || values[0] != 3 || values[1] != 2 {
+		t.Errorf("FromReverse() streamed %v", values)
+	}
+
+	var indices []int
+	for i, value := range FromReverse2(reversible{1, 2, 3}) {
+		if value != i+1 {
+			t.Errorf("FromReverse2() streamed (%d, %d)", i, value)
+		}
+
+		indices = append(indices, i)
+	}
+
+	if len(indices) != 3 || indices[0] != 2 || indices[2] != 0 {
+		t.Errorf("FromReverse2() streamed indices %v", indices)
+	}
+}